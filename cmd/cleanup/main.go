@@ -160,6 +160,10 @@ func main() {
 
 	// --- IaaS ---
 	runner.CustomNamings()
+	runner.FlavorProfiles()
+	runner.ImageProfiles()
+	runner.NetworkProfiles()
+	runner.StorageProfiles()
 	runner.Tags()
 
 	// --- Governance ---
@@ -169,4 +173,7 @@ func main() {
 
 	// --- Projects (last — may own other resources) ---
 	runner.Projects()
+
+	// --- Cloud zones (after the projects they are assigned to) ---
+	runner.CloudZones()
 }
@@ -0,0 +1,17 @@
+data "aria_fabric_network" "by_name" {
+  name = "DMZ"
+}
+
+# Narrow the lookup if the name is used in many regions
+data "aria_fabric_network" "by_name_and_region" {
+  name               = "VM Network"
+  external_region_id = "Datacenter:datacenter-2"
+}
+
+data "aria_fabric_network" "by_id" {
+  id = "e5f4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c"
+}
+
+output "dmz_cidr" {
+  value = data.aria_fabric_network.by_name.cidr
+}
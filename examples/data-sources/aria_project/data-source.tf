@@ -0,0 +1,11 @@
+data "aria_project" "by_name" {
+  name = "Platform Team"
+}
+
+data "aria_project" "by_id" {
+  id = "b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a"
+}
+
+output "project_id" {
+  value = data.aria_project.by_name.id
+}
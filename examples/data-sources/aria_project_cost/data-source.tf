@@ -0,0 +1,7 @@
+data "aria_project_cost" "example" {
+  project_id = "b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a"
+}
+
+output "project_cost" {
+  value = "${data.aria_project_cost.example.cost} ${data.aria_project_cost.example.cost_unit}"
+}
@@ -0,0 +1,13 @@
+ephemeral "aria_access_token" "current" {}
+
+# Call an API not (yet) covered by the provider, the token is never persisted
+provider "http" {}
+
+data "http" "deployments" {
+  url = "${ephemeral.aria_access_token.current.host}/deployment/api/deployments"
+
+  request_headers = {
+    Accept        = "application/json"
+    Authorization = "Bearer ${ephemeral.aria_access_token.current.token}"
+  }
+}
@@ -0,0 +1,26 @@
+# Render the content of a blueprint (e.g. to export it or to share it with other tools)
+output "cloud_template_content" {
+  value = provider::aria::cloud_template_content({
+    formatVersion = 1
+    inputs = {
+      flavor = {
+        title   = "Flavor"
+        type    = "string"
+        default = "small"
+        oneOf = [
+          { const = "small", title = "Small" },
+          { const = "large", title = "Large" },
+        ]
+      }
+    }
+    resources = {
+      vm = {
+        type = "Cloud.vSphere.Machine"
+        properties = {
+          image  = "ubuntu"
+          flavor = "$${input.flavor}"
+        }
+      }
+    }
+  })
+}
@@ -0,0 +1,17 @@
+resource "aria_icon" "example" {
+  path = "${path.module}/icons/example.svg"
+}
+
+# Replace the catalog item icon when the content of the icon changes
+resource "aria_catalog_item_icon" "example" {
+  item_id = data.aria_catalog_item.example.id
+  icon_id = aria_icon.example.id
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.icon_hash]
+  }
+}
+
+resource "terraform_data" "icon_hash" {
+  input = provider::aria::icon_hash(filebase64("${path.module}/icons/example.svg"))
+}
@@ -0,0 +1,4 @@
+# Check the icon of the platform matches the local file
+output "icon_is_up_to_date" {
+  value = aria_icon.example.hash == provider::aria::icon_hash_file("${path.module}/icons/example.svg")
+}
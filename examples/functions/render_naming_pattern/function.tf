@@ -0,0 +1,11 @@
+# Returns "demo-web-012"
+output "vm_name" {
+  value = provider::aria::render_naming_pattern(
+    "$${project.name}-$${resource.name}-###",
+    {
+      project  = { name = "demo" }
+      resource = { name = "web" }
+    },
+    { start_counter = 10, increment_step = 1, index = 2 },
+  )
+}
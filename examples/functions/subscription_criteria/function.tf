@@ -0,0 +1,4 @@
+# Returns "event.data.actionName == \"Foo\" && !event.data.retry"
+output "criteria" {
+  value = provider::aria::subscription_criteria("event.data.actionName=='Foo' &&!event.data.retry")
+}
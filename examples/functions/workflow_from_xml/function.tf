@@ -0,0 +1,27 @@
+locals {
+  workflow = provider::aria::workflow_from_xml(file("${path.module}/workflows/delete-deployment.xml"))
+}
+
+resource "aria_orchestrator_workflow" "delete_deployment" {
+  name        = local.workflow.name
+  description = local.workflow.description
+  category_id = aria_orchestrator_category.example.id
+  version     = local.workflow.version
+
+  allowed_operations      = local.workflow.allowed_operations
+  attrib                  = local.workflow.attrib
+  object_name             = local.workflow.object_name
+  position                = local.workflow.position
+  presentation            = local.workflow.presentation
+  restart_mode            = local.workflow.restart_mode
+  resume_from_failed_mode = local.workflow.resume_from_failed_mode
+  root_name               = local.workflow.root_name
+  workflow_item           = local.workflow.workflow_item
+
+  input_parameters  = local.workflow.input_parameters
+  output_parameters = local.workflow.output_parameters
+
+  api_version = local.workflow.api_version
+
+  input_forms = jsonencode([])
+}
@@ -0,0 +1,7 @@
+list "aria_orchestrator_action" "actions" {
+  provider = aria
+
+  config {
+    module = "com.example.utils"
+  }
+}
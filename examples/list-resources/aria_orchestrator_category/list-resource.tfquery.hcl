@@ -0,0 +1,7 @@
+list "aria_orchestrator_category" "workflow_categories" {
+  provider = aria
+
+  config {
+    type = "WorkflowCategory"
+  }
+}
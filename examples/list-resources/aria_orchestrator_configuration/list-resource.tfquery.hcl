@@ -0,0 +1,7 @@
+list "aria_orchestrator_configuration" "configurations" {
+  provider = aria
+
+  config {
+    name_prefix = "Settings"
+  }
+}
@@ -0,0 +1,8 @@
+list "aria_orchestrator_workflow" "workflows" {
+  provider = aria
+
+  config {
+    name_prefix = "Deploy "
+    category_id = "b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a"
+  }
+}
@@ -0,0 +1,7 @@
+list "aria_policy" "policies" {
+  provider = aria
+
+  config {
+    name_prefix = "Lease "
+  }
+}
@@ -0,0 +1,7 @@
+list "aria_property_group" "property_groups" {
+  provider = aria
+
+  config {
+    project_id = "b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a"
+  }
+}
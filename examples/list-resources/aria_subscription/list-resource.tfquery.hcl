@@ -0,0 +1,7 @@
+list "aria_subscription" "subscriptions" {
+  provider = aria
+
+  config {
+    name_prefix = "Notify "
+  }
+}
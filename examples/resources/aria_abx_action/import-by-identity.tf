@@ -0,0 +1,7 @@
+import {
+  to = aria_abx_action.example
+  identity = {
+    id         = "9ea6205b-e0e1-4188-b275-b17299efe49a"
+    project_id = "b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a"
+  }
+}
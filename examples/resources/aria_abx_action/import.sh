@@ -0,0 +1,3 @@
+# ABX action can be imported by specifying its project's unique identifier (or name) and its name.
+terraform import aria_abx_action.example b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a:setHostname
+terraform import aria_abx_action.example "Development:setHostname"
@@ -3,6 +3,19 @@ resource "aria_abx_sensitive_constant" "example" {
   value = "1234pass"
 }
 
+# Requires Terraform 1.11+, the value is never stored in the state
+variable "api_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "aria_abx_sensitive_constant" "api_key" {
+  name             = "THIS_IS_MY_API_KEY"
+  value_wo         = var.api_key
+  value_wo_version = 1 # Increment to apply a new value
+}
+
 output "example_sensitive_constant" {
   value = "My sensitive constant ${aria_abx_sensitive_constant.example.name} ID is ${aria_abx_sensitive_constant.example.id}"
 }
@@ -0,0 +1,6 @@
+import {
+  to = aria_catalog_item_icon.example
+  identity = {
+    item_id = "e5fa0338-943d-42fa-bb99-a29096b1cf4c"
+  }
+}
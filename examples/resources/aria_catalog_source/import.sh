@@ -0,0 +1,5 @@
+# Catalog source can be imported by specifying the instance's unique identifier.
+terraform import aria_catalog_source.example e2b1f4a3-5c6d-4e7f-8a9b-0c1d2e3f4a5b
+
+# Or by specifying its name.
+terraform import aria_catalog_source.example "Example"
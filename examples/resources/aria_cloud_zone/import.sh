@@ -0,0 +1,2 @@
+# Cloud zone can be imported by specifying the instance's unique identifier.
+terraform import aria_cloud_zone.example 8a6bf4c2-3a79-4e2f-a4b3-9c8a2b1d0e7f
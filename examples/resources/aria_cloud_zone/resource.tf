@@ -0,0 +1,38 @@
+resource "aria_tag" "env_prod" {
+  key   = "env"
+  value = "prod"
+}
+
+resource "aria_tag" "cluster_gold" {
+  key   = "cluster"
+  value = "gold"
+}
+
+# Computes are selected by tags
+resource "aria_cloud_zone" "prod" {
+  name             = "Production"
+  description      = "Production workloads."
+  region_id        = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+  placement_policy = "SPREAD"
+  folder           = "Production/VMs"
+
+  custom_properties = {
+    "__vmFolder" = "Production"
+  }
+
+  tags = [
+    { key = aria_tag.env_prod.key, value = aria_tag.env_prod.value }
+  ]
+
+  compute_tags = [
+    { key = aria_tag.cluster_gold.key, value = aria_tag.cluster_gold.value }
+  ]
+}
+
+# Computes are explicitly selected
+resource "aria_cloud_zone" "lab" {
+  name        = "Lab"
+  description = "Lab workloads."
+  region_id   = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+  compute_ids = ["a6c2e0b4-0f3e-4d8e-9c6b-5a1f2e3d4c5b"]
+}
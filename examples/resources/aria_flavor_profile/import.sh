@@ -0,0 +1,2 @@
+# Flavor profile can be imported by specifying the instance's unique identifier.
+terraform import aria_flavor_profile.example 5f3e2d1c-0b9a-4c8d-8e7f-6a5b4c3d2e1f
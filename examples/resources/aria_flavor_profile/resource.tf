@@ -0,0 +1,25 @@
+# vSphere flavors are mapped to a CPU count and memory
+resource "aria_flavor_profile" "vsphere" {
+  name        = "vSphere Standard Sizing"
+  description = "Standard sizing of our vSphere region."
+  region_id   = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+
+  flavor_mapping = {
+    small  = { cpu_count = 1, memory_mb = 2048 }
+    medium = { cpu_count = 2, memory_mb = 4096 }
+    large  = { cpu_count = 4, memory_mb = 8192 }
+  }
+}
+
+# Cloud flavors are mapped to an instance type
+resource "aria_flavor_profile" "aws" {
+  name        = "AWS Standard Sizing"
+  description = "Standard sizing of our AWS region."
+  region_id   = "9e1d7c3a-5b2f-4a6e-8d0c-1f3b5a7c9e2d"
+
+  flavor_mapping = {
+    small  = { instance_type = "t3.small" }
+    medium = { instance_type = "t3.medium" }
+    large  = { instance_type = "t3.large" }
+  }
+}
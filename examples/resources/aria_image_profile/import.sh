@@ -0,0 +1,3 @@
+# Image profile can be imported by specifying the instance's unique identifier.
+# The images are then referenced by identifier (image_id).
+terraform import aria_image_profile.example 0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f
@@ -0,0 +1,24 @@
+resource "aria_image_profile" "example" {
+  name        = "Golden Images"
+  description = "Golden images of our vSphere region, rebuilt every month."
+  region_id   = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+
+  image_mapping = {
+    # Referenced by identifier
+    ubuntu-24 = {
+      image_id = "7a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+    }
+
+    # Referenced by name, constrained to the computes tagged env:prod
+    debian-13 = {
+      image_name = "debian-13-golden-2026-10"
+      constraints = [
+        { expression = "env:prod" }
+      ]
+      cloud_config = <<EOT
+#cloud-config
+package_upgrade: true
+EOT
+    }
+  }
+}
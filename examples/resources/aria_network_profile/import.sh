@@ -0,0 +1,2 @@
+# Network profile can be imported by specifying the instance's unique identifier.
+terraform import aria_network_profile.example 1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d
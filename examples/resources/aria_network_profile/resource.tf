@@ -0,0 +1,34 @@
+data "aria_fabric_network" "dmz" {
+  name = "DMZ"
+}
+
+data "aria_fabric_network" "backend" {
+  name = "Backend"
+}
+
+resource "aria_network_profile" "example" {
+  name        = "Production Networks"
+  description = "Networks of our vSphere region."
+  region_id   = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+
+  fabric_network_ids = [
+    data.aria_fabric_network.dmz.id,
+    data.aria_fabric_network.backend.id,
+  ]
+
+  tags = [
+    { key = "env", value = "prod" }
+  ]
+}
+
+# On-demand networks allocated from a network domain
+resource "aria_network_profile" "isolated" {
+  name        = "Isolated Networks"
+  description = "On-demand networks of our vSphere region."
+  region_id   = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+
+  isolation_type                       = "SUBNET"
+  isolation_network_domain_cidr        = "10.10.0.0/16"
+  isolated_network_cidr_prefix         = 24
+  isolation_external_fabric_network_id = data.aria_fabric_network.backend.id
+}
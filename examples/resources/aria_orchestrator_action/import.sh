@@ -1,2 +1,5 @@
 # Orchestrator action can be imported by specifying the instance's unique identifier.
 terraform import aria_orchestrator_action.example 90c8291b-e71b-44f6-8f94-be9c0edc7867
+
+# Or by specifying its fully qualified name (module/name).
+terraform import aria_orchestrator_action.example com.example.foo/getFoo
@@ -1,2 +1,5 @@
 # Configuration can be imported by specifying the instance's unique identifier.
 terraform import aria_orchestrator_configuration.example 21b65dcb-41c2-4734-a7b3-c1a1a3138339
+
+# Or by specifying the path of its category and its name.
+terraform import aria_orchestrator_configuration.example "Example/Settings"
@@ -0,0 +1,5 @@
+# Workflow can be imported by specifying the instance's unique identifier.
+terraform import aria_orchestrator_workflow.example 2b8c4d6e-0f1a-4b3c-9d5e-7f8a9b0c1d2e
+
+# Or by specifying the path of its category and its name.
+terraform import aria_orchestrator_workflow.example "Library/Example/Do Something"
@@ -1,2 +1,5 @@
 # Policy can be imported by specifying the instance's unique identifier.
 terraform import aria_policy.example 9ea6205b-e0e1-4188-b275-b17299efe49a
+
+# Or by specifying its name.
+terraform import aria_policy.example "Example"
@@ -0,0 +1,2 @@
+# Project membership can be imported by specifying the project's unique identifier, the type of principal and its email.
+terraform import aria_project_membership.example b5ab3d4e-8a8f-4b4d-9a6e-3d0e4f0e1c2a:group:devops@example.com
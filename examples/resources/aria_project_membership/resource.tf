@@ -0,0 +1,21 @@
+# variables.tf
+
+variable "project_id" {
+  type = string
+}
+
+# main.tf
+
+resource "aria_project_membership" "devops" {
+  project_id = var.project_id
+  email      = "devops@example.com"
+  type       = "group"
+  role       = "administrator"
+}
+
+resource "aria_project_membership" "john" {
+  project_id = var.project_id
+  email      = "john.doe@example.com"
+  type       = "user"
+  role       = "viewer"
+}
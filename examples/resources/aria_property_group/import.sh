@@ -1,2 +1,5 @@
 # Property group can be imported by specifying the instance's unique identifier.
 terraform import aria_property_group.example ce238cb9-05e4-403a-9b31-d70ecb04466a
+
+# Or by specifying its name.
+terraform import aria_property_group.example "Example"
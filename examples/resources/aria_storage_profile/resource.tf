@@ -0,0 +1,29 @@
+resource "aria_storage_profile" "example" {
+  name         = "Gold Storage"
+  description  = "Fast datastore of our vSphere region."
+  region_id    = "4b9c1f0e-7c8a-4f4f-9f5e-2d7a3c5b1e6f"
+  cloud_type   = "vsphere"
+  default_item = true
+
+  vsphere = {
+    datastore_id      = "7d2e4a1c-9b3f-4e5d-8a6c-1f0b2d3e4c5a"
+    provisioning_type = "thin"
+    limit_iops        = 2000
+  }
+
+  tags = [
+    { key = "tier", value = "gold" }
+  ]
+}
+
+resource "aria_storage_profile" "aws" {
+  name        = "EBS Storage"
+  description = "Provisioned IOPS volumes of our AWS region."
+  region_id   = "9f8e7d6c-5b4a-4c3d-8e2f-1a0b9c8d7e6f"
+  cloud_type  = "aws"
+
+  aws = {
+    volume_type = "io2"
+    iops        = 3000
+  }
+}
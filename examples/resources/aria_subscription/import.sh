@@ -1,2 +1,5 @@
 # Subscription can be imported by specifying the instance's unique identifier.
 terraform import aria_subscription.example 72cfc94c-4706-4fd3-801d-c185d26266df
+
+# Or by specifying its name.
+terraform import aria_subscription.example "Example"
@@ -1,2 +1,5 @@
 # Tag can be imported by specifying the instance's unique identifier.
 terraform import aria_tag.example 9ea6205b-e0e1-4188-b275-b17299efe49a
+
+# Or by specifying its key and value.
+terraform import aria_tag.example env:production
@@ -0,0 +1,31 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewABXActionListResource() list.ListResource {
+	return &GenericListResource{config: ABXActionListResourceConfig()}
+}
+
+func ABXActionListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_abx_action",
+		NewResource: NewABXActionResource,
+		ListPath:    "abx/api/resources/actions",
+		Format:      LIST_FORMAT_CONTENT,
+		Filters: []ListFilter{
+			{
+				Attribute:   "project_id",
+				Description: "List only the actions of this project",
+				Field:       "projectId",
+				QueryParam:  "projectId",
+			},
+		},
+		IdentityFields:             map[string]string{"id": "id", "project_id": "projectId"},
+		ImportKeyFields:            []string{"projectId", "name"},
+		ImportKeySeparator:         ":",
+		ImportKeyAlternativeFields: []string{"projectName", "name"},
+	}
+}
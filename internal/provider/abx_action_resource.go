@@ -8,9 +8,11 @@ import "github.com/hashicorp/terraform-plugin-framework/resource"
 func NewABXActionResource() resource.Resource {
 	return &GenericResource[ABXActionModel, *ABXActionModel, ABXActionAPIModel]{
 		config: GenericResourceConfig{
-			TypeName:    "_abx_action",
-			SchemaFunc:  ABXActionSchema,
-			CreateCodes: []int{200},
+			TypeName:           "_abx_action",
+			SchemaFunc:         ABXActionSchema,
+			ListResourceConfig: ABXActionListResourceConfig,
+			CreateCodes:        []int{200},
+			IdentityAttributes: []string{"id", "project_id"},
 		},
 	}
 }
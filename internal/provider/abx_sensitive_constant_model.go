@@ -4,8 +4,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -16,6 +20,10 @@ type ABXSensitiveConstantModel struct {
 	Value     types.String `tfsdk:"value"`
 	Encrypted types.Bool   `tfsdk:"encrypted"`
 	OrgId     types.String `tfsdk:"org_id"`
+
+	// Write-only, only available in the configuration
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
 }
 
 // ABXSensitiveConstantAPIModel describes the resource API model.
@@ -67,10 +75,22 @@ func (self *ABXSensitiveConstantModel) FromAPI(raw ABXSensitiveConstantAPIModel)
 	self.OrgId = types.StringValue(raw.OrgId)
 }
 
+// Retrieve the write-only attributes from the configuration.
+func (self *ABXSensitiveConstantModel) FromConfig(
+	ctx context.Context,
+	config tfsdk.Config,
+) diag.Diagnostics {
+	return config.GetAttribute(ctx, path.Root("value_wo"), &self.ValueWO)
+}
+
 func (self ABXSensitiveConstantModel) ToAPI() ABXSensitiveConstantAPIModel {
+	value := self.Value.ValueString()
+	if !self.ValueWO.IsNull() {
+		value = self.ValueWO.ValueString()
+	}
 	return ABXSensitiveConstantAPIModel{
 		Name:      self.Name.ValueString(),
-		Value:     self.Value.ValueString(),
+		Value:     value,
 		Encrypted: self.Encrypted.ValueBool(),
 	}
 }
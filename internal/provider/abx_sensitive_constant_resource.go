@@ -13,6 +13,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ABXSensitiveConstantResource{}
+var _ resource.ResourceWithIdentity = &ABXSensitiveConstantResource{}
+var _ resource.ResourceWithUpgradeState = &ABXSensitiveConstantResource{}
 
 func NewABXSensitiveConstantResource() resource.Resource {
 	return &ABXSensitiveConstantResource{}
@@ -39,6 +41,18 @@ func (self *ABXSensitiveConstantResource) Schema(
 	resp.Schema = ABXSensitiveConstantSchema()
 }
 
+func (self *ABXSensitiveConstantResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(ABXSensitiveConstantSchema().Version)
+}
+
+func (self *ABXSensitiveConstantResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *ABXSensitiveConstantResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -55,6 +69,7 @@ func (self *ABXSensitiveConstantResource) Create(
 	// Read Terraform plan data into the model
 	var constant ABXSensitiveConstantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &constant)...)
+	resp.Diagnostics.Append(constant.FromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -69,6 +84,7 @@ func (self *ABXSensitiveConstantResource) Create(
 	// Save sensitive constant into Terraform state
 	constant.FromAPI(constantFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &constant)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", constant.String()))
 }
 
@@ -99,6 +115,7 @@ func (self *ABXSensitiveConstantResource) Read(
 	// Save updated secret into Terraform state
 	constant.FromAPI(constantFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &constant)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *ABXSensitiveConstantResource) Update(
@@ -109,6 +126,7 @@ func (self *ABXSensitiveConstantResource) Update(
 	// Read Terraform plan data into the model
 	var constant ABXSensitiveConstantModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &constant)...)
+	resp.Diagnostics.Append(constant.FromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -123,6 +141,7 @@ func (self *ABXSensitiveConstantResource) Update(
 	// Save sensitive constant into Terraform state
 	constant.FromAPI(constantFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &constant)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", constant.String()))
 }
 
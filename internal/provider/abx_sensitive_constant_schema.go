@@ -4,8 +4,12 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func ABXSensitiveConstantSchema() schema.Schema {
@@ -18,9 +22,27 @@ func ABXSensitiveConstantSchema() schema.Schema {
 				Required:            true,
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value (cannot be enforced since API don't return it)",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "Value (cannot be enforced since API don't return it), " +
+					"prefer `value_wo` to prevent storing the value in the state",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Value (write-only, never stored in the state, requires " +
+					"Terraform 1.11+), update `value_wo_version` to apply a new value",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `value_wo`, change it to update the value",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"encrypted": schema.BoolAttribute{
 				MarkdownDescription: "Should be always encrypted!",
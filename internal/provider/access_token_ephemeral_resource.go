@@ -0,0 +1,77 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+// AccessTokenEphemeralResource defines the ephemeral resource implementation.
+type AccessTokenEphemeralResource struct {
+	client *AriaClient
+}
+
+func (self *AccessTokenEphemeralResource) Metadata(
+	ctx context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (self *AccessTokenEphemeralResource) Schema(
+	ctx context.Context,
+	req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = AccessTokenSchema()
+}
+
+func (self *AccessTokenEphemeralResource) Configure(
+	ctx context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	self.client = GetEphemeralResourceClient(ctx, req, resp)
+}
+
+func (self *AccessTokenEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	host := self.client.Host
+	if self.client.Hosts != nil {
+		host = self.client.Hosts.Active()
+	}
+
+	token := self.client.AccessToken
+	if len(self.client.RefreshToken) > 0 {
+		var err error
+		token, err = self.client.RequestAccessToken()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to retrieve a valid access token, got error: %s", err))
+			return
+		}
+	}
+
+	accessToken := AccessTokenModel{}
+	accessToken.FromToken(host, token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &accessToken)...)
+	tflog.Debug(ctx, fmt.Sprintf("Opened %s successfully", accessToken.String()))
+}
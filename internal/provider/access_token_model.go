@@ -0,0 +1,33 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccessTokenModel describes the ephemeral resource data model.
+type AccessTokenModel struct {
+	Host      types.String      `tfsdk:"host"`
+	Token     types.String      `tfsdk:"token"`
+	ExpiresAt timetypes.RFC3339 `tfsdk:"expires_at"`
+}
+
+func (self AccessTokenModel) String() string {
+	return "Access Token for " + self.Host.ValueString()
+}
+
+// Save the token (its expiry is unknown if not a JWT).
+func (self *AccessTokenModel) FromToken(host string, token string) {
+	self.Host = types.StringValue(host)
+	self.Token = types.StringValue(token)
+	if expiresAt, err := GetAccessTokenExpiry(token); err == nil {
+		self.ExpiresAt = timetypes.NewRFC3339TimeValue(expiresAt.UTC().Truncate(time.Second))
+	} else {
+		self.ExpiresAt = timetypes.NewRFC3339Null()
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessTokenModelFromToken(t *testing.T) {
+	expiresAt := time.Date(2050, 1, 2, 3, 4, 5, 0, time.UTC)
+	accessToken := AccessTokenModel{}
+	accessToken.FromToken("https://aria.local", fakeJWT(expiresAt))
+	CheckEqual(t, accessToken.Host.ValueString(), "https://aria.local")
+	CheckEqual(t, accessToken.Token.ValueString(), fakeJWT(expiresAt))
+	CheckEqual(t, accessToken.ExpiresAt.ValueString(), "2050-01-02T03:04:05Z")
+
+	// Not a JWT, expiry is unknown
+	accessToken.FromToken("https://aria.local", "opaque-token")
+	CheckEqual(t, accessToken.ExpiresAt.IsNull(), true)
+}
+
+func TestAriaClientRequestAccessToken(t *testing.T) {
+	logins := 0
+	token := fakeJWT(time.Now().Add(8 * time.Hour))
+	client := newCachingClient(t, newLoginAPI(t, token, &logins), "refresh-token", "")
+	CheckEqual(t, logins, 1)
+
+	// A new token is requested, the client's token is left unchanged
+	client.AccessToken = "provider-token"
+	requested, err := client.RequestAccessToken()
+	if err != nil {
+		t.Fatalf("RequestAccessToken: %s", err)
+	}
+	CheckEqual(t, requested, token)
+	CheckEqual(t, client.AccessToken, "provider-token")
+	CheckEqual(t, logins, 2)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func AccessTokenSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Access token (bearer) for calling the API of the platform from " +
+			"other providers or scripts, obtained the same way as the provider (login with the " +
+			"refresh token). Being ephemeral, the token is never persisted in the plan or state." +
+			"\n\nThe access token of the provider is returned if the provider is configured with " +
+			"an `access_token` instead of a `refresh_token`.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "URL of the API (the active host if `fallback_hosts` is set)",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry date of the access token (RFC3339), " +
+					"null if not known",
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CatalogItemIconResource{}
+var _ resource.ResourceWithIdentity = &CatalogItemIconResource{}
 var _ resource.ResourceWithImportState = &CatalogItemIconResource{}
+var _ resource.ResourceWithUpgradeState = &CatalogItemIconResource{}
 
 func NewCatalogItemIconResource() resource.Resource {
 	return &CatalogItemIconResource{}
@@ -41,6 +43,18 @@ func (self *CatalogItemIconResource) Schema(
 	resp.Schema = CatalogItemIconSchema()
 }
 
+func (self *CatalogItemIconResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CatalogItemIconSchema().Version)
+}
+
+func (self *CatalogItemIconResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("item_id")
+}
+
 func (self *CatalogItemIconResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -82,6 +96,7 @@ func (self *CatalogItemIconResource) Create(
 	// Save item's icon into Terraform state
 	itemIcon.FromAPI(itemIconFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &itemIcon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", itemIcon.String()))
 }
 
@@ -112,6 +127,7 @@ func (self *CatalogItemIconResource) Read(
 	// Save updated item's icon into Terraform state
 	itemIcon.FromAPI(itemIconFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &itemIcon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CatalogItemIconResource) Update(
@@ -147,6 +163,7 @@ func (self *CatalogItemIconResource) Update(
 	// Save updated item's icon into Terraform state
 	itemIcon.FromAPI(itemIconFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &itemIcon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", itemIcon.String()))
 }
 
@@ -163,5 +180,5 @@ func (self *CatalogItemIconResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("item_id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("item_id"), req, resp)
 }
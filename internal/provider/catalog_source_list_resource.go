@@ -0,0 +1,28 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewCatalogSourceListResource() list.ListResource {
+	return &GenericListResource{config: CatalogSourceListResourceConfig()}
+}
+
+func CatalogSourceListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_catalog_source",
+		NewResource: NewCatalogSourceResource,
+		ListPath:    "catalog/api/admin/sources",
+		Format:      LIST_FORMAT_CONTENT,
+		Filters: []ListFilter{
+			{
+				Attribute:   "project_id",
+				Description: "List only the catalog sources of this project",
+				Field:       "projectId",
+				QueryParam:  "projectId",
+			},
+		},
+		ImportKeyFields: []string{"name"},
+	}
+}
@@ -10,12 +10,17 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CatalogSourceResource{}
+var _ resource.ResourceWithIdentity = &CatalogSourceResource{}
+var _ resource.ResourceWithImportState = &CatalogSourceResource{}
+var _ resource.ResourceWithMoveState = &CatalogSourceResource{}
+var _ resource.ResourceWithUpgradeState = &CatalogSourceResource{}
 
 func NewCatalogSourceResource() resource.Resource {
 	return &CatalogSourceResource{}
@@ -42,6 +47,18 @@ func (self *CatalogSourceResource) Schema(
 	resp.Schema = CatalogSourceSchema()
 }
 
+func (self *CatalogSourceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CatalogSourceSchema().Version)
+}
+
+func (self *CatalogSourceResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *CatalogSourceResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -83,6 +100,7 @@ func (self *CatalogSourceResource) Create(
 	// Optionally wait imported then save updated catalog source into Terraform state
 	resp.Diagnostics.Append(self.WaitImported(ctx, &source)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &source)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated (post-import) %s successfully", source.String()))
 }
 
@@ -113,6 +131,7 @@ func (self *CatalogSourceResource) Read(
 	// Save updated catalog source into Terraform state
 	resp.Diagnostics.Append(source.FromAPI(ctx, sourceFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &source)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CatalogSourceResource) Update(
@@ -148,6 +167,7 @@ func (self *CatalogSourceResource) Update(
 	// Optionally wait imported then save updated catalog source into Terraform state
 	resp.Diagnostics.Append(self.WaitImported(ctx, &source)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &source)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated (post-import) %s successfully", source.String()))
 }
 
@@ -164,6 +184,35 @@ func (self *CatalogSourceResource) Delete(
 	}
 }
 
+func (self *CatalogSourceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	ImportStateByIdOrNaturalKey(ctx, self.client, CatalogSourceListResourceConfig(), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("import_trigger"), "")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_imported"), true)...)
+}
+
+func (self *CatalogSourceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return MoveStateFromSources(
+		MoveStateSource{
+			TypeName: "vra_catalog_source_blueprint",
+			Attributes: map[string]string{
+				"id":          "id",
+				"name":        "name",
+				"description": "description",
+				"project_id":  "project_id",
+			},
+			SetAttributes: map[string]any{
+				"type_id":        "com.vmw.blueprint",
+				"import_trigger": "",
+				"wait_imported":  true,
+			},
+		},
+	)
+}
+
 // -------------------------------------------------------------------------------------------------
 
 func (self *CatalogSourceResource) WaitImported(
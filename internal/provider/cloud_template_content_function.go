@@ -0,0 +1,108 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CloudTemplateContentFunction{}
+
+func NewCloudTemplateContentFunction() function.Function {
+	return &CloudTemplateContentFunction{}
+}
+
+// CloudTemplateContentFunction defines the function implementation.
+type CloudTemplateContentFunction struct{}
+
+func (self *CloudTemplateContentFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cloud_template_content"
+}
+
+func (self *CloudTemplateContentFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Render the content (YAML) of a cloud template",
+		MarkdownDescription: "Render the content (YAML) of a cloud template (blueprint) from an " +
+			"object with `formatVersion`, `inputs`, `resources` and `outputs`.\n\n" +
+			"Inputs are encoded the same way as the `inputs` of the `aria_cloud_template_v1` " +
+			"resource (`title`, `description`, `type`, `default`, `encrypted`, `readOnly`, " +
+			"`minimum`, `oneOf`, ...) and resources are made of a `type`, `metadata`, " +
+			"`properties` and `allocatePerInstance`. Unknown keys are rejected.\n\n" +
+			"The output is encoded the same way as the content of the `aria_cloud_template_v1` " +
+			"resource: names (of inputs, resources, ...) are sorted, unset and default values " +
+			"are omitted, so the content is stable across runs and does not generate " +
+			"spurious diffs.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "content",
+				MarkdownDescription: "Content of the cloud template",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *CloudTemplateContentFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var content types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	yamlContent, err := RenderCloudTemplateContent(content)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, yamlContent))
+}
+
+// Render the content of a cloud template (blueprint) to YAML.
+// The value is decoded into the API model to validate its structure and encode it the same way
+// as the cloud template resource.
+func RenderCloudTemplateContent(content types.Dynamic) (string, error) {
+	raw, err := AttrValueToAny(content)
+	if err != nil {
+		return "", fmt.Errorf("Unable to convert content, got error: %s", err)
+	}
+
+	jsonContent, err := json.Marshal(raw)
+	if err != nil {
+		return "", fmt.Errorf("Unable to marshal content to JSON, got error: %s", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonContent))
+	decoder.DisallowUnknownFields()
+	contentRaw := CloudTemplateV1ContentAPIModel{}
+	if err := decoder.Decode(&contentRaw); err != nil {
+		return "", fmt.Errorf("Invalid cloud template content, got error: %s", err)
+	}
+
+	yamlContent, err := yaml.Marshal(contentRaw)
+	if err != nil {
+		return "", fmt.Errorf("Unable to marshal content to YAML, got error: %s", err)
+	}
+	return string(yamlContent), nil
+}
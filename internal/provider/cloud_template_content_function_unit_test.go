@@ -0,0 +1,107 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderCloudTemplateContent(t *testing.T) {
+	replicas := types.ObjectValueMust(
+		map[string]attr.Type{
+			"title":       types.StringType,
+			"description": types.StringType,
+			"type":        types.StringType,
+			"default":     types.NumberType,
+			"readOnly":    types.BoolType,
+			"minimum":     types.NumberType,
+			"maximum":     types.NumberType,
+		},
+		map[string]attr.Value{
+			"title":       types.StringValue("Replicas"),
+			"description": types.StringNull(),
+			"type":        types.StringValue("integer"),
+			"default":     types.NumberValue(big.NewFloat(2)),
+			"readOnly":    types.BoolValue(false),
+			"minimum":     types.NumberValue(big.NewFloat(1)),
+			"maximum":     types.NumberNull(),
+		},
+	)
+	vm := types.ObjectValueMust(
+		map[string]attr.Type{
+			"type":       types.StringType,
+			"properties": types.MapType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"type": types.StringValue("Cloud.vSphere.Machine"),
+			"properties": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"image":  types.StringValue("ubuntu"),
+				"flavor": types.StringValue("small"),
+			}),
+		},
+	)
+	content := types.ObjectValueMust(
+		map[string]attr.Type{
+			"formatVersion": types.NumberType,
+			"inputs":        types.ObjectType{AttrTypes: map[string]attr.Type{"replicas": replicas.Type(nil)}},
+			"resources":     types.ObjectType{AttrTypes: map[string]attr.Type{"vm": vm.Type(nil)}},
+		},
+		map[string]attr.Value{
+			"formatVersion": types.NumberValue(big.NewFloat(1)),
+			"inputs": types.ObjectValueMust(
+				map[string]attr.Type{"replicas": replicas.Type(nil)},
+				map[string]attr.Value{"replicas": replicas},
+			),
+			"resources": types.ObjectValueMust(
+				map[string]attr.Type{"vm": vm.Type(nil)},
+				map[string]attr.Value{"vm": vm},
+			),
+		},
+	)
+
+	// Unset and default values are omitted
+	yamlContent, err := RenderCloudTemplateContent(types.DynamicValue(content))
+	if err != nil {
+		t.Fatalf("RenderCloudTemplateContent: %s", err)
+	}
+	CheckEqual(t, yamlContent, strings.Join([]string{
+		"formatVersion: 1",
+		"inputs:",
+		"  replicas:",
+		"    title: Replicas",
+		"    type: integer",
+		"    default: 2",
+		"    minimum: 1",
+		"resources:",
+		"  vm:",
+		"    type: Cloud.vSphere.Machine",
+		"    properties:",
+		"      flavor: small",
+		"      image: ubuntu",
+		"",
+	}, "\n"))
+}
+
+func TestRenderCloudTemplateContentUnknownKey(t *testing.T) {
+	content := types.ObjectValueMust(
+		map[string]attr.Type{"resource": types.StringType},
+		map[string]attr.Value{"resource": types.StringValue("typo")},
+	)
+	_, err := RenderCloudTemplateContent(types.DynamicValue(content))
+	if err == nil || !strings.Contains(err.Error(), `unknown field "resource"`) {
+		t.Errorf("Expected an unknown field error, got %v", err)
+	}
+}
+
+func TestRenderCloudTemplateContentUnknownValue(t *testing.T) {
+	_, err := RenderCloudTemplateContent(types.DynamicUnknown())
+	if err == nil || !strings.Contains(err.Error(), "value is unknown") {
+		t.Errorf("Expected an unknown value error, got %v", err)
+	}
+}
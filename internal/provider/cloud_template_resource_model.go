@@ -24,12 +24,13 @@ type CloudTemplateResourceModel struct {
 
 // CloudTemplateResourceAPIModel describes the resource API model.
 type CloudTemplateResourceAPIModel struct {
-	Type string `yaml:"type"`
+	Type string `json:"type" yaml:"type"`
 
-	/*Metadata   CloudTemplateResourceMetadataModel   `json:"metadata"`*/
-	/*Properties CloudTemplateResourcePropertiesModel `json:"properties"`*/
+	// Not (yet) managed by the resource, rendered by the cloud_template_content function
+	Metadata   map[string]any `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Properties map[string]any `json:"properties,omitempty" yaml:"properties,omitempty"`
 
-	AllocatePerInstance *bool `yaml:"allocatePerInstance,omitempty"`
+	AllocatePerInstance *bool `json:"allocatePerInstance,omitempty" yaml:"allocatePerInstance,omitempty"`
 }
 
 func (self CloudTemplateResourceModel) String() string {
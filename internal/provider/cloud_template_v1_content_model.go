@@ -5,7 +5,8 @@ package provider
 
 // CloudTemplateV1ContentAPIModel describes the resource API model.
 type CloudTemplateV1ContentAPIModel struct {
-	/*FormatVersion string                         `json:"formatVersion"`*/
-	Inputs    UnorderedPropertiesAPIModel    `yaml:"inputs"`
-	Resources CloudTemplateResourcesAPIModel `yaml:"resources"`
+	FormatVersion int                            `json:"formatVersion,omitempty" yaml:"formatVersion,omitempty"`
+	Inputs        UnorderedPropertiesAPIModel    `json:"inputs" yaml:"inputs"`
+	Resources     CloudTemplateResourcesAPIModel `json:"resources" yaml:"resources"`
+	Outputs       map[string]any                 `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 }
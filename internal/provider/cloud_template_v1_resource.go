@@ -14,7 +14,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CloudTemplateV1Resource{}
+var _ resource.ResourceWithIdentity = &CloudTemplateV1Resource{}
 var _ resource.ResourceWithImportState = &CloudTemplateV1Resource{}
+var _ resource.ResourceWithMoveState = &CloudTemplateV1Resource{}
+var _ resource.ResourceWithUpgradeState = &CloudTemplateV1Resource{}
 
 func NewCloudTemplateV1Resource() resource.Resource {
 	return &CloudTemplateV1Resource{}
@@ -41,6 +44,18 @@ func (self *CloudTemplateV1Resource) Schema(
 	resp.Schema = CloudTemplateV1Schema()
 }
 
+func (self *CloudTemplateV1Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CloudTemplateV1Schema().Version)
+}
+
+func (self *CloudTemplateV1Resource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *CloudTemplateV1Resource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -87,6 +102,7 @@ func (self *CloudTemplateV1Resource) Create(
 	// Save cloud template into Terraform state
 	resp.Diagnostics.Append(template.FromAPI(ctx, templateFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &template)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", template.String()))
 }
 
@@ -117,6 +133,7 @@ func (self *CloudTemplateV1Resource) Read(
 	// Save updated cloud template into Terraform state
 	resp.Diagnostics.Append(template.FromAPI(ctx, templateRaw)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &template)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CloudTemplateV1Resource) Update(
@@ -147,6 +164,7 @@ func (self *CloudTemplateV1Resource) Update(
 	// Save updated cloud template into Terraform state
 	resp.Diagnostics.Append(template.FromAPI(ctx, templateFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &template)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", template.String()))
 }
 
@@ -169,5 +187,20 @@ func (self *CloudTemplateV1Resource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// FIXME must be filtered by id and projectId
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+}
+
+func (self *CloudTemplateV1Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return MoveStateFromSources(
+		MoveStateSource{
+			TypeName: "vra_blueprint",
+			Attributes: map[string]string{
+				"id":                "id",
+				"name":              "name",
+				"description":       "description",
+				"project_id":        "project_id",
+				"request_scope_org": "request_scope_org",
+			},
+		},
+	)
 }
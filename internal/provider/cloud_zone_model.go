@@ -0,0 +1,153 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudZoneModel describes the resource data model.
+type CloudZoneModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	RegionId        types.String `tfsdk:"region_id"`
+	PlacementPolicy types.String `tfsdk:"placement_policy"`
+	Folder          types.String `tfsdk:"folder"`
+
+	CustomProperties types.Map `tfsdk:"custom_properties"`
+
+	// Of type IaaSTagModel
+	Tags        types.Set `tfsdk:"tags"`
+	ComputeTags types.Set `tfsdk:"compute_tags"`
+
+	// Of type string
+	ComputeIds types.Set `tfsdk:"compute_ids"`
+
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	CloudAccountId   types.String `tfsdk:"cloud_account_id"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// CloudZoneAPIModel describes the resource API model.
+type CloudZoneAPIModel struct {
+	Id              string `json:"id,omitempty"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	RegionId        string `json:"regionId,omitempty"`
+	PlacementPolicy string `json:"placementPolicy"`
+	Folder          string `json:"folder"`
+
+	CustomProperties map[string]string `json:"customProperties"`
+
+	Tags        []TagAPIModel `json:"tags"`
+	TagsToMatch []TagAPIModel `json:"tagsToMatch"`
+
+	// Omitted if nil (computes are selected by tags), not returned by the API
+	ComputeIds []string `json:"computeIds,omitzero"`
+
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	Links map[string]IaaSLinkAPIModel `json:"_links,omitempty"`
+}
+
+func (self CloudZoneModel) String() string {
+	return fmt.Sprintf(
+		"Cloud Zone %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of cloud zones.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self CloudZoneModel) LockKey() string {
+	return "cloud-zone-" + self.Id.ValueString()
+}
+
+func (self CloudZoneModel) CreatePath() string {
+	return "iaas/api/zones"
+}
+
+func (self CloudZoneModel) ReadPath() string {
+	return "iaas/api/zones/" + self.Id.ValueString()
+}
+
+func (self CloudZoneModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self CloudZoneModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *CloudZoneModel) FromAPI(
+	ctx context.Context,
+	raw CloudZoneAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.PlacementPolicy = types.StringValue(raw.PlacementPolicy)
+	self.Folder = types.StringValue(raw.Folder)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// Region is kept as is if not returned by the API
+	if regionId := IaaSRegionId(raw.RegionId, raw.Links); len(regionId) > 0 {
+		self.RegionId = types.StringValue(regionId)
+	}
+
+	// Compute IDs are not returned by the API (kept as is)
+
+	customProperties, diags := types.MapValueFrom(ctx, types.StringType, raw.CustomProperties)
+	self.CustomProperties = customProperties
+
+	var someDiags diag.Diagnostics
+	self.Tags, someDiags = IaaSTagsFromAPI(ctx, raw.Tags)
+	diags.Append(someDiags...)
+	self.ComputeTags, someDiags = IaaSTagsFromAPI(ctx, raw.TagsToMatch)
+	diags.Append(someDiags...)
+
+	return diags
+}
+
+func (self CloudZoneModel) ToAPI(
+	ctx context.Context,
+) (CloudZoneAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	customPropertiesRaw := make(map[string]string, len(self.CustomProperties.Elements()))
+	diags.Append(self.CustomProperties.ElementsAs(ctx, &customPropertiesRaw, false)...)
+
+	tagsRaw, someDiags := IaaSTagsToAPI(ctx, self.Tags)
+	diags.Append(someDiags...)
+	computeTagsRaw, someDiags := IaaSTagsToAPI(ctx, self.ComputeTags)
+	diags.Append(someDiags...)
+
+	var computeIdsRaw []string
+	if !self.ComputeIds.IsNull() && !self.ComputeIds.IsUnknown() {
+		computeIdsRaw = make([]string, 0, len(self.ComputeIds.Elements()))
+		diags.Append(self.ComputeIds.ElementsAs(ctx, &computeIdsRaw, false)...)
+	}
+
+	return CloudZoneAPIModel{
+		Name:             self.Name.ValueString(),
+		Description:      CleanString(self.Description.ValueString()),
+		RegionId:         self.RegionId.ValueString(),
+		PlacementPolicy:  self.PlacementPolicy.ValueString(),
+		Folder:           self.Folder.ValueString(),
+		CustomProperties: customPropertiesRaw,
+		Tags:             tagsRaw,
+		TagsToMatch:      computeTagsRaw,
+		ComputeIds:       computeIdsRaw,
+	}, diags
+}
@@ -0,0 +1,51 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCloudZoneModelAPI(t *testing.T) {
+	ctx := t.Context()
+	zone := CloudZoneModel{ComputeIds: types.SetNull(types.StringType)}
+	diags := zone.FromAPI(ctx, CloudZoneAPIModel{
+		Id:               "zone-1",
+		Name:             "Production",
+		PlacementPolicy:  "SPREAD",
+		CustomProperties: map[string]string{"__vmFolder": "Production"},
+		Tags:             []TagAPIModel{{Key: "env", Value: "prod"}},
+		TagsToMatch:      []TagAPIModel{{Key: "cluster", Value: "gold"}},
+		Links: map[string]IaaSLinkAPIModel{
+			"region": {Href: "/iaas/api/regions/region-1"},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, zone.RegionId.ValueString(), "region-1")
+	CheckEqual(t, len(zone.Tags.Elements()), 1)
+	CheckEqual(t, len(zone.ComputeTags.Elements()), 1)
+
+	// Computes are selected by tags, compute IDs are omitted
+	raw, diags := zone.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, raw.RegionId, "region-1")
+	CheckDeepEqual(t, raw.Tags, []TagAPIModel{{Key: "env", Value: "prod"}})
+	CheckDeepEqual(t, raw.TagsToMatch, []TagAPIModel{{Key: "cluster", Value: "gold"}})
+	CheckEqual(t, raw.ComputeIds == nil, true)
+
+	// Computes are explicitly selected, compute IDs are kept as is (not returned by the API)
+	zone.ComputeIds = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("compute-1")})
+	zone.ComputeTags, diags = IaaSTagsFromAPI(ctx, nil)
+	CheckDiagnostics(t, diags, "", "")
+	raw, diags = zone.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckDeepEqual(t, raw.ComputeIds, []string{"compute-1"})
+	CheckEqual(t, raw.TagsToMatch != nil && len(raw.TagsToMatch) == 0, true)
+
+	CheckDiagnostics(t, zone.FromAPI(ctx, raw), "", "")
+	CheckEqual(t, len(zone.ComputeIds.Elements()), 1)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+func NewCloudZoneResource() resource.Resource {
+	return &GenericResource[CloudZoneModel, *CloudZoneModel, CloudZoneAPIModel]{
+		config: GenericResourceConfig{
+			TypeName:     "_cloud_zone",
+			SchemaFunc:   CloudZoneSchema,
+			UpdateMethod: "PATCH",
+		},
+	}
+}
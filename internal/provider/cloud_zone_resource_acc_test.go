@@ -0,0 +1,104 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_tag" "test" {
+  key   = "ARIA_PROVIDER_TEST_CLOUD_ZONE"
+  value = "compute"
+}
+
+resource "aria_cloud_zone" "test" {
+  name        = "ARIA_PROVIDER_TEST_CLOUD_ZONE"
+  description = "Temporary cloud zone generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  compute_tags = [
+    { key = aria_tag.test.key, value = aria_tag.test.value }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "id"),
+					resource.TestCheckResourceAttr(
+						"aria_cloud_zone.test", "name", "ARIA_PROVIDER_TEST_CLOUD_ZONE"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "placement_policy", "DEFAULT"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "folder", ""),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "custom_properties.%", "0"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "compute_tags.#", "1"),
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "cloud_account_id"),
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "org_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_tag" "test" {
+  key   = "ARIA_PROVIDER_TEST_CLOUD_ZONE"
+  value = "compute"
+}
+
+resource "aria_cloud_zone" "test" {
+  name             = "ARIA_PROVIDER_TEST_CLOUD_ZONE_RENAMED"
+  description      = "Temporary cloud zone generated by Aria provider's acceptance tests."
+  region_id        = var.test_region_id
+  placement_policy = "BINPACK"
+
+  custom_properties = {
+    some_property = "some value"
+  }
+
+  tags = [
+    { key = "ARIA_PROVIDER_TEST_CLOUD_ZONE", value = "zone" }
+  ]
+
+  compute_tags = [
+    { key = aria_tag.test.key, value = aria_tag.test.value }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aria_cloud_zone.test", "name", "ARIA_PROVIDER_TEST_CLOUD_ZONE_RENAMED"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "placement_policy", "BINPACK"),
+					resource.TestCheckResourceAttr(
+						"aria_cloud_zone.test", "custom_properties.some_property", "some value"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.0.value", "zone"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_cloud_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CLOUD_ZONE_PLACEMENT_POLICIES = []string{"DEFAULT", "SPREAD", "BINPACK"}
+
+func CloudZoneSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Cloud zone resource",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"description": RequiredDescriptionSchema(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_policy": schema.StringAttribute{
+				MarkdownDescription: "Placement policy, either `DEFAULT` (the default), " +
+					"`SPREAD` or `BINPACK`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("DEFAULT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(CLOUD_ZONE_PLACEMENT_POLICIES...),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder where the resources are provisioned " +
+					"(vSphere only, defaults to an empty string)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"custom_properties": IaaSCustomPropertiesSchema(
+				"Custom properties attached to the resources provisioned in the cloud zone"),
+			"tags":         IaaSTagsSchema("Tags of the cloud zone"),
+			"compute_tags": CloudZoneComputeTagsSchema(),
+			"compute_ids": schema.SetAttribute{
+				MarkdownDescription: "Computes (hosts, clusters, resource pools) of the " +
+					"cloud zone, explicitly selected by identifier. The API is not returning " +
+					"them so changes made outside Terraform are not detected.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"external_region_id": ComputedIdentifierSchema(
+				"Identifier of the region on the cloud provider side"),
+			"cloud_account_id": ComputedIdentifierSchema("Cloud account identifier"),
+			"org_id":           ComputedOrganizationIdSchema(),
+		},
+	}
+}
+
+func CloudZoneComputeTagsSchema() schema.SetNestedAttribute {
+	computeTags := IaaSTagsSchema(
+		"Computes (hosts, clusters, resource pools) of the cloud zone are the ones matching " +
+			"those tags")
+	computeTags.Validators = []validator.Set{
+		setvalidator.ConflictsWith(path.MatchRoot("compute_ids")),
+	}
+	return computeTags
+}
@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomFormResource{}
+var _ resource.ResourceWithIdentity = &CustomFormResource{}
 var _ resource.ResourceWithImportState = &CustomFormResource{}
+var _ resource.ResourceWithUpgradeState = &CustomFormResource{}
 
 func NewCustomFormResource() resource.Resource {
 	return &CustomFormResource{}
@@ -41,6 +45,18 @@ func (self *CustomFormResource) Schema(
 	resp.Schema = CustomFormSchema()
 }
 
+func (self *CustomFormResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CustomFormSchema().Version)
+}
+
+func (self *CustomFormResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("source_id", "source_type")
+}
+
 func (self *CustomFormResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -62,20 +78,9 @@ func (self *CustomFormResource) Create(
 	}
 
 	// First, try to fetch (existing form)
-	var formFromFetchAPI CustomFormAPIModel
-	path := form.FetchPath()
-	response, err := self.client.R(path).
-		SetQueryParam("formFormat", "JSON").
-		SetQueryParam("formType", form.Type.ValueString()).
-		SetQueryParam("sourceId", form.SourceId.ValueString()).
-		SetQueryParam("sourceType", form.SourceType.ValueString()).
-		SetResult(&formFromFetchAPI).
-		Get(path)
-	err = self.client.HandleAPIResponse(response, err, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Client error",
-			fmt.Sprintf("Unable to fetch %s, got error: %s", form.String(), err))
+	formFromFetchAPI, fetchDiags := self.FetchIt(form)
+	resp.Diagnostics.Append(fetchDiags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -83,8 +88,8 @@ func (self *CustomFormResource) Create(
 	form.GenerateId(formFromFetchAPI.Id)
 
 	// Then create (or update) it
-	path = form.CreatePath()
-	response, err = self.client.R(path).SetBody(form.ToAPI()).Post(path)
+	path := form.CreatePath()
+	response, err := self.client.R(path).SetBody(form.ToAPI()).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -104,6 +109,7 @@ func (self *CustomFormResource) Create(
 	// Save custom form into Terraform state
 	form.FromAPI(formFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &form)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", form.String()))
 }
 
@@ -119,6 +125,20 @@ func (self *CustomFormResource) Read(
 		return
 	}
 
+	// Imported by identity, retrieve the identifier of the form from its source
+	if len(form.Id.ValueString()) == 0 {
+		formFromFetchAPI, fetchDiags := self.FetchIt(form)
+		resp.Diagnostics.Append(fetchDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(formFromFetchAPI.Id) == 0 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		form.Id = types.StringValue(formFromFetchAPI.Id)
+	}
+
 	var formFromAPI CustomFormAPIModel
 	found, _, readDiags := self.client.ReadIt(&form, &formFromAPI)
 	resp.Diagnostics.Append(readDiags...)
@@ -134,6 +154,7 @@ func (self *CustomFormResource) Read(
 	// Save updated custom form into Terraform state
 	form.FromAPI(formFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &form)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CustomFormResource) Update(
@@ -169,6 +190,7 @@ func (self *CustomFormResource) Update(
 	// Save custom form into Terraform state
 	form.FromAPI(formFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &form)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", form.String()))
 }
 
@@ -191,5 +213,31 @@ func (self *CustomFormResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+	if len(req.ID) == 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), "requestForm")...)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+
+// Fetch the form of the source (the identifier is empty if the form is missing).
+func (self *CustomFormResource) FetchIt(form CustomFormModel) (CustomFormAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	var formFromFetchAPI CustomFormAPIModel
+	path := form.FetchPath()
+	response, err := self.client.R(path).
+		SetQueryParam("formFormat", "JSON").
+		SetQueryParam("formType", form.Type.ValueString()).
+		SetQueryParam("sourceId", form.SourceId.ValueString()).
+		SetQueryParam("sourceType", form.SourceType.ValueString()).
+		SetResult(&formFromFetchAPI).
+		Get(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200, 404})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to fetch %s, got error: %s", form.String(), err))
+	}
+	return formFromFetchAPI, diags
 }
@@ -14,7 +14,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomNamingResource{}
+var _ resource.ResourceWithIdentity = &CustomNamingResource{}
 var _ resource.ResourceWithImportState = &CustomNamingResource{}
+var _ resource.ResourceWithUpgradeState = &CustomNamingResource{}
 
 func NewCustomNamingResource() resource.Resource {
 	return &CustomNamingResource{}
@@ -41,6 +43,18 @@ func (self *CustomNamingResource) Schema(
 	resp.Schema = CustomNamingSchema()
 }
 
+func (self *CustomNamingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CustomNamingSchema().Version)
+}
+
+func (self *CustomNamingResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *CustomNamingResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -87,6 +101,7 @@ func (self *CustomNamingResource) Create(
 	// Save custom naming into Terraform state
 	resp.Diagnostics.Append(naming.FromAPI(ctx, namingFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &naming)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", naming.String()))
 }
 
@@ -117,6 +132,7 @@ func (self *CustomNamingResource) Read(
 	// Save updated custom naming into Terraform state
 	resp.Diagnostics.Append(naming.FromAPI(ctx, namingFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &naming)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CustomNamingResource) Update(
@@ -148,6 +164,7 @@ func (self *CustomNamingResource) Update(
 	// Save updated custom naming into Terraform state
 	resp.Diagnostics.Append(naming.FromAPI(ctx, namingFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &naming)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", naming.String()))
 }
 
@@ -170,5 +187,5 @@ func (self *CustomNamingResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// FIXME must be filtered by id and projectId
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
 }
@@ -14,7 +14,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomResourceResource{}
+var _ resource.ResourceWithIdentity = &CustomResourceResource{}
 var _ resource.ResourceWithImportState = &CustomResourceResource{}
+var _ resource.ResourceWithUpgradeState = &CustomResourceResource{}
 
 func NewCustomResourceResource() resource.Resource {
 	return &CustomResourceResource{}
@@ -41,6 +43,18 @@ func (self *CustomResourceResource) Schema(
 	resp.Schema = CustomResourceSchema()
 }
 
+func (self *CustomResourceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(CustomResourceSchema().Version)
+}
+
+func (self *CustomResourceResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *CustomResourceResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -77,6 +91,7 @@ func (self *CustomResourceResource) Create(
 	// Save custom resource into Terraform state
 	resp.Diagnostics.Append(resource.FromAPI(ctx, resourceFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resource)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", resource.String()))
 }
 
@@ -110,6 +125,7 @@ func (self *CustomResourceResource) Read(
 	// Save updated custom resource into Terraform state
 	resp.Diagnostics.Append(resource.FromAPI(ctx, resourceFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resource)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *CustomResourceResource) Update(
@@ -164,6 +180,7 @@ func (self *CustomResourceResource) Update(
 	// Save updated custom resource into Terraform state
 	resp.Diagnostics.Append(resource.FromAPI(ctx, resourceFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resource)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", resource.String()))
 }
 
@@ -188,5 +205,5 @@ func (self *CustomResourceResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// FIXME must be filtered by id and projectId
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,155 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FabricNetworkDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &FabricNetworkDataSource{}
+
+func NewFabricNetworkDataSource() datasource.DataSource {
+	return &FabricNetworkDataSource{}
+}
+
+// FabricNetworkDataSource defines the data source implementation.
+type FabricNetworkDataSource struct {
+	client *AriaClient
+}
+
+func (self *FabricNetworkDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_network"
+}
+
+func (self *FabricNetworkDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = FabricNetworkDataSourceSchema()
+}
+
+func (self *FabricNetworkDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self FabricNetworkDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *FabricNetworkDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var network FabricNetworkModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var networkFromAPI FabricNetworkAPIModel
+
+	if len(network.Id.ValueString()) > 0 {
+		// Retrieve details from the network's API endpoint
+		path := network.ReadPath()
+		response, err := self.client.R(path).SetResult(&networkFromAPI).Get(path)
+		err = self.client.HandleAPIResponse(response, err, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to read %s, got error: %s", network.String(), err))
+			return
+		}
+	} else {
+		// Retrieve details from the networks API endpoint
+		var err error
+		networkFromAPI, err = self.Lookup(network)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to find %s, got error: %s", network.String(), err))
+			return
+		}
+	}
+
+	// Save fabric network into Terraform state
+	resp.Diagnostics.Append(network.FromAPI(ctx, networkFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &network)...)
+}
+
+// Return the fabric network matching exactly the name (and the external region if set).
+// An error is returned if there is no such network or if the name is ambiguous.
+func (self *FabricNetworkDataSource) Lookup(
+	network FabricNetworkModel,
+) (FabricNetworkAPIModel, error) {
+	name := network.Name.ValueString()
+	externalRegionId := network.ExternalRegionId.ValueString()
+	filter := fmt.Sprintf("name eq %s", ODataString(name))
+	if len(externalRegionId) > 0 {
+		filter += fmt.Sprintf(" and externalRegionId eq %s", ODataString(externalRegionId))
+	}
+
+	var listFromAPI FabricNetworkListAPIModel
+	listPath := network.ListPath()
+	response, err := self.client.R(listPath).
+		SetQueryParam("$filter", filter).
+		SetQueryParam("$top", "1000"). // Don't want to play with pagination
+		SetResult(&listFromAPI).
+		Get(listPath)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return FabricNetworkAPIModel{}, err
+	}
+
+	// The filter may not be exact (e.g. case insensitive), ensure attributes are matching
+	matches := []FabricNetworkAPIModel{}
+	for _, networkRaw := range listFromAPI.Content {
+		if networkRaw.Name == name &&
+			(len(externalRegionId) == 0 || networkRaw.ExternalRegionId == externalRegionId) {
+			matches = append(matches, networkRaw)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return FabricNetworkAPIModel{}, errors.New("no fabric network matching name")
+	case 1:
+		return matches[0], nil
+	default:
+		candidates := make([]string, 0, len(matches))
+		for _, match := range matches {
+			candidates = append(candidates, fmt.Sprintf("%s in %s", match.Id, match.ExternalRegionId))
+		}
+		return FabricNetworkAPIModel{}, fmt.Errorf(
+			"fabric network name is ambiguous, %d networks are matching (%s), "+
+				"set external_region_id or use id instead",
+			len(matches), strings.Join(candidates, ", "))
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricNetworkDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_fabric_network_id" {
+  description = "Fabric network to use for testing the data source."
+  type        = string
+}
+
+data "aria_fabric_network" "by_id" {
+  id = var.test_fabric_network_id
+}
+
+data "aria_fabric_network" "by_name" {
+  name               = data.aria_fabric_network.by_id.name
+  external_region_id = data.aria_fabric_network.by_id.external_region_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_fabric_network.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.aria_fabric_network.by_id", "external_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_fabric_network.by_name", "id",
+						"data.aria_fabric_network.by_id", "id",
+					),
+				),
+			},
+		},
+	})
+}
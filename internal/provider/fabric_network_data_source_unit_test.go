@@ -0,0 +1,57 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFabricNetworkDataSourceLookup(t *testing.T) {
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		CheckEqual(t, r.URL.Path, "/iaas/api/fabric-networks")
+		// Mimic a case insensitive filter, by name and optionally by external region
+		filter := r.URL.Query().Get("$filter")
+		content := []FabricNetworkAPIModel{}
+		for _, network := range []FabricNetworkAPIModel{
+			{Id: "network-1", Name: "VM Network", ExternalRegionId: "Datacenter:datacenter-2"},
+			{Id: "network-2", Name: "VM Network", ExternalRegionId: "Datacenter:datacenter-3"},
+			{Id: "network-3", Name: "DMZ", ExternalRegionId: "Datacenter:datacenter-2"},
+			{Id: "network-4", Name: "Ops' LAN", ExternalRegionId: "Datacenter:datacenter-2"},
+		} {
+			if strings.Contains(strings.ToLower(filter), strings.ToLower(ODataString(network.Name))) &&
+				(!strings.Contains(filter, "externalRegionId") ||
+					strings.Contains(filter, ODataString(network.ExternalRegionId))) {
+				content = append(content, network)
+			}
+		}
+		writeJSONStatus(w, http.StatusOK, FabricNetworkListAPIModel{Content: content})
+	})
+	dataSource := FabricNetworkDataSource{client: newTestClient(t, server.URL)}
+
+	network, err := dataSource.Lookup(FabricNetworkModel{Name: types.StringValue("DMZ")})
+	CheckEqual(t, err, nil)
+	CheckEqual(t, network.Id, "network-3")
+
+	_, err = dataSource.Lookup(FabricNetworkModel{Name: types.StringValue("VM Network")})
+	CheckEqual(t, err != nil && strings.Contains(err.Error(), "ambiguous"), true)
+
+	network, err = dataSource.Lookup(FabricNetworkModel{
+		Name:             types.StringValue("VM Network"),
+		ExternalRegionId: types.StringValue("Datacenter:datacenter-3"),
+	})
+	CheckEqual(t, err, nil)
+	CheckEqual(t, network.Id, "network-2")
+
+	network, err = dataSource.Lookup(FabricNetworkModel{Name: types.StringValue("Ops' LAN")})
+	CheckEqual(t, err, nil)
+	CheckEqual(t, network.Id, "network-4")
+
+	// Exact match only
+	_, err = dataSource.Lookup(FabricNetworkModel{Name: types.StringValue("dmz")})
+	CheckEqual(t, err != nil && strings.Contains(err.Error(), "no fabric network"), true)
+}
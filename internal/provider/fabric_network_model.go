@@ -0,0 +1,88 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FabricNetworkModel describes the data source data model.
+type FabricNetworkModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	ExternalId       types.String `tfsdk:"external_id"`
+	CIDR             types.String `tfsdk:"cidr"`
+	IsPublic         types.Bool   `tfsdk:"is_public"`
+	IsDefault        types.Bool   `tfsdk:"is_default"`
+	CloudAccountIds  types.Set    `tfsdk:"cloud_account_ids"` // Of type string
+	Tags             types.Set    `tfsdk:"tags"`              // Of type IaaSTagModel
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// FabricNetworkAPIModel describes the data source API model.
+type FabricNetworkAPIModel struct {
+	Id               string        `json:"id"`
+	Name             string        `json:"name"`
+	ExternalRegionId string        `json:"externalRegionId"`
+	ExternalId       string        `json:"externalId"`
+	CIDR             string        `json:"cidr"`
+	IsPublic         bool          `json:"isPublic"`
+	IsDefault        bool          `json:"isDefault"`
+	CloudAccountIds  []string      `json:"cloudAccountIds"`
+	Tags             []TagAPIModel `json:"tags"`
+	OrgId            string        `json:"orgId"`
+}
+
+type FabricNetworkListAPIModel struct {
+	Content          []FabricNetworkAPIModel `json:"content"`
+	TotalElements    int                     `json:"totalElements"`
+	NumberOfElements int                     `json:"numberOfElements"`
+}
+
+func (self FabricNetworkModel) String() string {
+	return fmt.Sprintf(
+		"Fabric Network %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+func (self FabricNetworkModel) ListPath() string {
+	return "iaas/api/fabric-networks"
+}
+
+func (self FabricNetworkModel) ReadPath() string {
+	return "iaas/api/fabric-networks/" + self.Id.ValueString()
+}
+
+func (self *FabricNetworkModel) FromAPI(
+	ctx context.Context,
+	raw FabricNetworkAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.ExternalId = types.StringValue(raw.ExternalId)
+	self.CIDR = types.StringValue(raw.CIDR)
+	self.IsPublic = types.BoolValue(raw.IsPublic)
+	self.IsDefault = types.BoolValue(raw.IsDefault)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	cloudAccountIdsRaw := raw.CloudAccountIds
+	if cloudAccountIdsRaw == nil {
+		cloudAccountIdsRaw = []string{}
+	}
+	cloudAccountIds, diags := types.SetValueFrom(ctx, types.StringType, cloudAccountIdsRaw)
+	self.CloudAccountIds = cloudAccountIds
+
+	var someDiags diag.Diagnostics
+	self.Tags, someDiags = IaaSTagsFromAPI(ctx, raw.Tags)
+	diags.Append(someDiags...)
+
+	return diags
+}
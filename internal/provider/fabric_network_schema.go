@@ -0,0 +1,75 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FabricNetworkDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Fabric network data source, lookup by identifier or (exact) name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier (either id or name must be set)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name, must match exactly one fabric network " +
+					"(either id or name must be set)",
+				Computed: true,
+				Optional: true,
+			},
+			"external_region_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the region on the cloud provider side " +
+					"(e.g. `Datacenter:datacenter-2`), narrow the lookup by name if set",
+				Computed: true,
+				Optional: true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier on the cloud provider side",
+				Computed:            true,
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "Network CIDR (e.g. `10.0.0.0/24`)",
+				Computed:            true,
+			},
+			"is_public": schema.BoolAttribute{
+				MarkdownDescription: "Whether the network is public",
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the network is the default one of the zone",
+				Computed:            true,
+			},
+			"cloud_account_ids": schema.SetAttribute{
+				MarkdownDescription: "Cloud accounts of the network",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tags": schema.SetNestedAttribute{
+				MarkdownDescription: "Tags of the network",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Key",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FlavorMappingModel describes the resource data model.
+type FlavorMappingModel struct {
+	CPUCount     types.Int32  `tfsdk:"cpu_count"`
+	MemoryMB     types.Int64  `tfsdk:"memory_mb"`
+	InstanceType types.String `tfsdk:"instance_type"`
+}
+
+// FlavorMappingAPIModel describes the resource API model.
+type FlavorMappingAPIModel struct {
+	CPUCount     int32  `json:"cpuCount,omitempty"`
+	MemoryMB     int64  `json:"memoryInMB,omitempty"`
+	InstanceType string `json:"name,omitempty"`
+}
+
+// FlavorMappingsAPIModel describes the flavor mappings returned by the API.
+type FlavorMappingsAPIModel struct {
+	Mapping map[string]FlavorMappingAPIModel `json:"mapping"`
+}
+
+func (self *FlavorMappingModel) FromAPI(raw FlavorMappingAPIModel) {
+	// Cloud flavors are mapped to an instance type (the API may also return its sizing)
+	if len(raw.InstanceType) > 0 {
+		self.CPUCount = types.Int32Null()
+		self.MemoryMB = types.Int64Null()
+		self.InstanceType = types.StringValue(raw.InstanceType)
+	} else {
+		self.CPUCount = types.Int32Value(raw.CPUCount)
+		self.MemoryMB = types.Int64Value(raw.MemoryMB)
+		self.InstanceType = types.StringNull()
+	}
+}
+
+func (self FlavorMappingModel) ToAPI() FlavorMappingAPIModel {
+	if len(self.InstanceType.ValueString()) > 0 {
+		return FlavorMappingAPIModel{InstanceType: self.InstanceType.ValueString()}
+	}
+	return FlavorMappingAPIModel{
+		CPUCount: self.CPUCount.ValueInt32(),
+		MemoryMB: self.MemoryMB.ValueInt64(),
+	}
+}
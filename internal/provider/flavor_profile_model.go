@@ -0,0 +1,115 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FlavorProfileModel describes the resource data model.
+type FlavorProfileModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	RegionId    types.String `tfsdk:"region_id"`
+
+	FlavorMapping map[string]FlavorMappingModel `tfsdk:"flavor_mapping"`
+
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	CloudAccountId   types.String `tfsdk:"cloud_account_id"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// FlavorProfileAPIModel describes the resource API model.
+type FlavorProfileAPIModel struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RegionId    string `json:"regionId,omitempty"`
+
+	// Flavor mapping is sent as flavorMapping, returned as flavorMappings.mapping
+	FlavorMapping  map[string]FlavorMappingAPIModel `json:"flavorMapping,omitempty"`
+	FlavorMappings FlavorMappingsAPIModel           `json:"flavorMappings,omitzero"`
+
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	Links map[string]IaaSLinkAPIModel `json:"_links,omitempty"`
+}
+
+func (self FlavorProfileModel) String() string {
+	return fmt.Sprintf(
+		"Flavor Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of flavor profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self FlavorProfileModel) LockKey() string {
+	return "flavor-profile-" + self.Id.ValueString()
+}
+
+func (self FlavorProfileModel) CreatePath() string {
+	return "iaas/api/flavor-profiles"
+}
+
+func (self FlavorProfileModel) ReadPath() string {
+	return "iaas/api/flavor-profiles/" + self.Id.ValueString()
+}
+
+func (self FlavorProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self FlavorProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *FlavorProfileModel) FromAPI(
+	ctx context.Context,
+	raw FlavorProfileAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// Region is kept as is if not returned by the API
+	if regionId := IaaSRegionId(raw.RegionId, raw.Links); len(regionId) > 0 {
+		self.RegionId = types.StringValue(regionId)
+	}
+
+	self.FlavorMapping = map[string]FlavorMappingModel{}
+	for name, mappingRaw := range raw.FlavorMappings.Mapping {
+		mapping := FlavorMappingModel{}
+		mapping.FromAPI(mappingRaw)
+		self.FlavorMapping[name] = mapping
+	}
+
+	return diag.Diagnostics{}
+}
+
+func (self FlavorProfileModel) ToAPI(
+	ctx context.Context,
+) (FlavorProfileAPIModel, diag.Diagnostics) {
+	flavorMappingRaw := map[string]FlavorMappingAPIModel{}
+	for name, mapping := range self.FlavorMapping {
+		flavorMappingRaw[name] = mapping.ToAPI()
+	}
+	return FlavorProfileAPIModel{
+		Name:          self.Name.ValueString(),
+		Description:   CleanString(self.Description.ValueString()),
+		RegionId:      self.RegionId.ValueString(),
+		FlavorMapping: flavorMappingRaw,
+	}, diag.Diagnostics{}
+}
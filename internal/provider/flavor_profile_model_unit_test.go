@@ -0,0 +1,42 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlavorProfileModelAPI(t *testing.T) {
+	ctx := t.Context()
+	profile := FlavorProfileModel{}
+	diags := profile.FromAPI(ctx, FlavorProfileAPIModel{
+		Id:   "profile-1",
+		Name: "Sizing",
+		FlavorMappings: FlavorMappingsAPIModel{
+			Mapping: map[string]FlavorMappingAPIModel{
+				"small": {CPUCount: 1, MemoryMB: 2048},
+				// Sizing of the instance type is ignored
+				"large": {InstanceType: "t3.large", CPUCount: 2, MemoryMB: 8192},
+			},
+		},
+		Links: map[string]IaaSLinkAPIModel{
+			"region": {Href: "/iaas/api/regions/region-1"},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, profile.RegionId.ValueString(), "region-1")
+	CheckEqual(t, profile.FlavorMapping["small"].CPUCount.ValueInt32(), int32(1))
+	CheckEqual(t, profile.FlavorMapping["small"].InstanceType.IsNull(), true)
+	CheckEqual(t, profile.FlavorMapping["large"].CPUCount.IsNull(), true)
+	CheckEqual(t, profile.FlavorMapping["large"].InstanceType.ValueString(), "t3.large")
+
+	// The mapping is sent as flavorMapping
+	raw, diags := profile.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	body, err := json.Marshal(raw)
+	CheckEqual(t, err, nil)
+	CheckEqual(t, string(body), `{"name":"Sizing","description":"","regionId":"region-1",`+
+		`"flavorMapping":{"large":{"name":"t3.large"},"small":{"cpuCount":1,"memoryInMB":2048}}}`)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+func NewFlavorProfileResource() resource.Resource {
+	return &GenericResource[FlavorProfileModel, *FlavorProfileModel, FlavorProfileAPIModel]{
+		config: GenericResourceConfig{
+			TypeName:     "_flavor_profile",
+			SchemaFunc:   FlavorProfileSchema,
+			UpdateMethod: "PATCH",
+		},
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFlavorProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_flavor_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"
+  description = "Temporary flavor profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  flavor_mapping = {
+    ARIA_PROVIDER_TEST_SMALL = { cpu_count = 1, memory_mb = 1024 }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "id"),
+					resource.TestCheckResourceAttr(
+						"aria_flavor_profile.test", "name", "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aria_flavor_profile.test", "flavor_mapping.ARIA_PROVIDER_TEST_SMALL.cpu_count", "1"),
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "cloud_account_id"),
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "org_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_flavor_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"
+  description = "Temporary flavor profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  flavor_mapping = {
+    ARIA_PROVIDER_TEST_SMALL = { cpu_count = 1, memory_mb = 2048 }
+    ARIA_PROVIDER_TEST_LARGE = { cpu_count = 4, memory_mb = 8192 }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.%", "2"),
+					resource.TestCheckResourceAttr(
+						"aria_flavor_profile.test", "flavor_mapping.ARIA_PROVIDER_TEST_SMALL.memory_mb", "2048"),
+					resource.TestCheckResourceAttr(
+						"aria_flavor_profile.test", "flavor_mapping.ARIA_PROVIDER_TEST_LARGE.cpu_count", "4"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_flavor_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func FlavorProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Flavor profile resource, map the flavors to a sizing in a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"description": RequiredDescriptionSchema(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flavor_mapping": schema.MapNestedAttribute{
+				MarkdownDescription: "Flavors, flavor name (e.g. `small`) to sizing " +
+					"(CPU count and memory for vSphere, instance type for the clouds)",
+				Required: true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cpu_count": schema.Int32Attribute{
+							MarkdownDescription: "Number of CPUs (vSphere)",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+								int32validator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("memory_mb")),
+							},
+						},
+						"memory_mb": schema.Int64Attribute{
+							MarkdownDescription: "Amount of memory in MB (vSphere)",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("cpu_count")),
+							},
+						},
+						"instance_type": schema.StringAttribute{
+							MarkdownDescription: "Instance type (clouds, e.g. `t2.micro`)",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("cpu_count")),
+							},
+						},
+					},
+				},
+			},
+			"external_region_id": ComputedIdentifierSchema(
+				"Identifier of the region on the cloud provider side"),
+			"cloud_account_id": ComputedIdentifierSchema("Cloud account identifier"),
+			"org_id":           ComputedOrganizationIdSchema(),
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IaaSConstraintModel describes a placement constraint of an infrastructure resource.
+// The schema is the same as the one of the project's constraints (ProjectConstraintListSchema).
+type IaaSConstraintModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Mandatory  types.Bool   `tfsdk:"mandatory"`
+}
+
+// IaaSConstraintAPIModel describes the resource API model.
+type IaaSConstraintAPIModel struct {
+	Expression string `json:"expression"`
+	Mandatory  bool   `json:"mandatory"`
+}
+
+func (self IaaSConstraintModel) String() string {
+	return fmt.Sprintf("Constraint %s", self.Expression.ValueString())
+}
+
+func (self *IaaSConstraintModel) FromAPI(raw IaaSConstraintAPIModel) {
+	self.Expression = types.StringValue(raw.Expression)
+	self.Mandatory = types.BoolValue(raw.Mandatory)
+}
+
+func (self IaaSConstraintModel) ToAPI() IaaSConstraintAPIModel {
+	return IaaSConstraintAPIModel{
+		Expression: self.Expression.ValueString(),
+		Mandatory:  self.Mandatory.ValueBool(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self IaaSConstraintModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"expression": types.StringType,
+		"mandatory":  types.BoolType,
+	}
+}
+
+// Convert the constraints from raw to list.
+func IaaSConstraintsFromAPI(
+	ctx context.Context,
+	raw []IaaSConstraintAPIModel,
+) (types.List, diag.Diagnostics) {
+	constraints := make([]IaaSConstraintModel, 0, len(raw))
+	for _, constraintRaw := range raw {
+		constraint := IaaSConstraintModel{}
+		constraint.FromAPI(constraintRaw)
+		constraints = append(constraints, constraint)
+	}
+	attrs := types.ObjectType{AttrTypes: IaaSConstraintModel{}.AttributeTypes()}
+	return types.ListValueFrom(ctx, attrs, constraints)
+}
+
+// Convert the constraints from list to raw (always a slice, an empty one to remove all constraints).
+func IaaSConstraintsToAPI(
+	ctx context.Context,
+	list types.List,
+) ([]IaaSConstraintAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	constraints := make([]IaaSConstraintModel, 0, len(list.Elements()))
+	if !list.IsNull() && !list.IsUnknown() {
+		diags.Append(list.ElementsAs(ctx, &constraints, false)...)
+	}
+	constraintsRaw := make([]IaaSConstraintAPIModel, 0, len(constraints))
+	for _, constraint := range constraints {
+		constraintsRaw = append(constraintsRaw, constraint.ToAPI())
+	}
+	return constraintsRaw, diags
+}
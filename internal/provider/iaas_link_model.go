@@ -0,0 +1,43 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// IaaSLinkAPIModel describes a link (_links) to a related infrastructure resource.
+type IaaSLinkAPIModel struct {
+	Href  string   `json:"href,omitempty"`
+	Hrefs []string `json:"hrefs,omitempty"` // Links to many resources
+}
+
+// Return the identifier of the linked resource (empty if there is no such link).
+func IaaSLinkId(links map[string]IaaSLinkAPIModel, name string) string {
+	link, ok := links[name]
+	if !ok || len(link.Href) == 0 {
+		return ""
+	}
+	return idFromHref(link.Href)
+}
+
+// Return the identifiers of the linked resources (empty if there is no such link).
+func IaaSLinkIds(links map[string]IaaSLinkAPIModel, name string) []string {
+	link, ok := links[name]
+	if !ok {
+		return []string{}
+	}
+	ids := make([]string, 0, len(link.Hrefs))
+	for _, href := range link.Hrefs {
+		ids = append(ids, idFromHref(href))
+	}
+	if len(ids) == 0 && len(link.Href) > 0 {
+		ids = append(ids, idFromHref(link.Href))
+	}
+	return ids
+}
+
+// Return the identifier of the region, only returned as a link by most of the APIs.
+func IaaSRegionId(regionId string, links map[string]IaaSLinkAPIModel) string {
+	if len(regionId) > 0 {
+		return regionId
+	}
+	return IaaSLinkId(links, "region")
+}
@@ -0,0 +1,35 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func IaaSCustomPropertiesSchema(description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: description + " (defaults to none)",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Default: mapdefault.StaticValue(
+			types.MapValueMust(types.StringType, map[string]attr.Value{})),
+	}
+}
+
+// Identifiers of the related resources (e.g. fabric networks of a network profile).
+func IaaSIdentifiersSchema(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: description + " (defaults to none)",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Default: setdefault.StaticValue(
+			types.SetValueMust(types.StringType, []attr.Value{})),
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IaaSTagModel describes a tag attached to (or matched by) an infrastructure resource.
+// The API model is the same as the one of the aria_tag resource.
+type IaaSTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (self IaaSTagModel) String() string {
+	return fmt.Sprintf("Tag %s:%s", self.Key.ValueString(), self.Value.ValueString())
+}
+
+func (self *IaaSTagModel) FromAPI(raw TagAPIModel) {
+	self.Key = types.StringValue(raw.Key)
+	self.Value = types.StringValue(raw.Value)
+}
+
+func (self IaaSTagModel) ToAPI() TagAPIModel {
+	return TagAPIModel{
+		Key:   self.Key.ValueString(),
+		Value: self.Value.ValueString(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self IaaSTagModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// Convert the tags from raw to set.
+func IaaSTagsFromAPI(ctx context.Context, raw []TagAPIModel) (types.Set, diag.Diagnostics) {
+	tags := make([]IaaSTagModel, 0, len(raw))
+	for _, tagRaw := range raw {
+		tag := IaaSTagModel{}
+		tag.FromAPI(tagRaw)
+		tags = append(tags, tag)
+	}
+	attrs := types.ObjectType{AttrTypes: IaaSTagModel{}.AttributeTypes()}
+	return types.SetValueFrom(ctx, attrs, tags)
+}
+
+// Convert the tags from set to raw (always a slice, an empty one to remove all tags).
+func IaaSTagsToAPI(ctx context.Context, set types.Set) ([]TagAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	tags := make([]IaaSTagModel, 0, len(set.Elements()))
+	if !set.IsNull() && !set.IsUnknown() {
+		diags.Append(set.ElementsAs(ctx, &tags, false)...)
+	}
+	tagsRaw := make([]TagAPIModel, 0, len(tags))
+	for _, tag := range tags {
+		tagsRaw = append(tagsRaw, tag.ToAPI())
+	}
+	return tagsRaw, diags
+}
@@ -0,0 +1,38 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Tags of infrastructure resources, key and value can be taken from an `aria_tag` resource.
+func IaaSTagsSchema(description string) schema.SetNestedAttribute {
+	attrs := types.ObjectType{AttrTypes: IaaSTagModel{}.AttributeTypes()}
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description + " (e.g. `{ key = aria_tag.env.key, value = " +
+			"aria_tag.env.value }`), defaults to no tags",
+		Optional: true,
+		Computed: true,
+		Default:  setdefault.StaticValue(types.SetValueMust(attrs, []attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					MarkdownDescription: "Key",
+					Required:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Value (defaults to an empty string)",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IconHashFileFunction{}
+
+func NewIconHashFileFunction() function.Function {
+	return &IconHashFileFunction{}
+}
+
+// IconHashFileFunction defines the function implementation.
+type IconHashFileFunction struct{}
+
+func (self *IconHashFileFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "icon_hash_file"
+}
+
+func (self *IconHashFileFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the hash of an icon file",
+		MarkdownDescription: "Compute the hash of an icon file, the same way as the `hash` " +
+			"attribute of the `aria_icon` resource (SHA-256, hex encoded).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "path",
+				MarkdownDescription: "Path to the icon file (relative paths are resolved from the " +
+					"current working directory, prefer `path.module`)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *IconHashFileFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var path string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			0, fmt.Sprintf("Unable to read icon file, got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, IconHash(content)))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IconHashFunction{}
+
+func NewIconHashFunction() function.Function {
+	return &IconHashFunction{}
+}
+
+// IconHashFunction defines the function implementation.
+type IconHashFunction struct{}
+
+func (self *IconHashFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "icon_hash"
+}
+
+func (self *IconHashFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the hash of an icon's content",
+		MarkdownDescription: "Compute the hash of an icon's content, the same way as the `hash` " +
+			"attribute of the `aria_icon` resource (SHA-256, hex encoded).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content_base64",
+				MarkdownDescription: "Content of the icon (base64 encoded, e.g. `filebase64(...)`)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *IconHashFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var contentBase64 string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &contentBase64))
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := base64.StdEncoding.DecodeString(contentBase64)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			0, fmt.Sprintf("Unable to decode content (base64), got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, IconHash(content)))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sha256 of "icon"
+const testIconHash = "c2d4b446a44ce54fab8e01150e24dd24f3d850c7c14dcfe31f6321341dd86874"
+
+// runStringFunction runs the function with the given arguments and returns its (string) result.
+func runStringFunction(
+	t *testing.T,
+	instance function.Function,
+	arguments ...attr.Value,
+) (types.String, *function.FuncError) {
+	t.Helper()
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	instance.Run(t.Context(), function.RunRequest{
+		Arguments: function.NewArgumentsData(arguments),
+	}, &resp)
+	result, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("Unexpected result %v", resp.Result.Value())
+	}
+	return result, resp.Error
+}
+
+func TestIconHash(t *testing.T) {
+	CheckEqual(t, IconHash([]byte("icon")), testIconHash)
+}
+
+func TestIconHashFunction(t *testing.T) {
+	hash, err := runStringFunction(t, NewIconHashFunction(), types.StringValue("aWNvbg=="))
+	if err != nil {
+		t.Fatalf("icon_hash: %s", err)
+	}
+	CheckEqual(t, hash.ValueString(), testIconHash)
+
+	_, err = runStringFunction(t, NewIconHashFunction(), types.StringValue("not base64!"))
+	if err == nil {
+		t.Error("Expected an error when content is not base64 encoded")
+	}
+}
+
+func TestIconHashFileFunction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "icon.svg")
+	if err := os.WriteFile(path, []byte("icon"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+
+	hash, err := runStringFunction(t, NewIconHashFileFunction(), types.StringValue(path))
+	if err != nil {
+		t.Fatalf("icon_hash_file: %s", err)
+	}
+	CheckEqual(t, hash.ValueString(), testIconHash)
+
+	_, err = runStringFunction(t, NewIconHashFileFunction(), types.StringValue(path+".missing"))
+	if err == nil {
+		t.Error("Expected an error when file does not exist")
+	}
+}
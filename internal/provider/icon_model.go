@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +24,11 @@ type IconDataSourceModel struct {
 	Content types.String `tfsdk:"content"`
 }
 
+// Return the hash of the content of an icon (used to detect content changes).
+func IconHash(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
 func (self IconModel) String() string {
 	return fmt.Sprintf("Icon %s", self.Id.ValueString())
 }
@@ -5,7 +5,6 @@ package provider
 
 import (
 	"context"
-	"crypto/sha256"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +14,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IconResource{}
+var _ resource.ResourceWithIdentity = &IconResource{}
+var _ resource.ResourceWithUpgradeState = &IconResource{}
 
 func NewIconResource() resource.Resource {
 	return &IconResource{}
@@ -41,6 +42,18 @@ func (self *IconResource) Schema(
 	resp.Schema = IconSchema()
 }
 
+func (self *IconResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(IconSchema().Version)
+}
+
+func (self *IconResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *IconResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -105,8 +118,9 @@ func (self *IconResource) Create(
 	}
 
 	// Save updated icon into Terraform state
-	icon.Hash = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(response.Body())))
+	icon.Hash = types.StringValue(IconHash(response.Body()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &icon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Refreshed %s successfully", icon.String()))
 
 }
@@ -144,8 +158,9 @@ func (self *IconResource) Read(
 	}
 
 	// Save updated icon into Terraform state
-	icon.Hash = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(response.Body())))
+	icon.Hash = types.StringValue(IconHash(response.Body()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &icon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *IconResource) Update(
@@ -162,6 +177,7 @@ func (self *IconResource) Update(
 
 	// Save updated icon into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &icon)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", icon.String()))
 }
 
@@ -0,0 +1,63 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImageMappingModel describes the resource data model.
+type ImageMappingModel struct {
+	ImageId     types.String `tfsdk:"image_id"`
+	ImageName   types.String `tfsdk:"image_name"`
+	Constraints types.List   `tfsdk:"constraints"` // Of type IaaSConstraintModel
+	CloudConfig types.String `tfsdk:"cloud_config"`
+}
+
+// ImageMappingAPIModel describes the resource API model.
+type ImageMappingAPIModel struct {
+	ImageId     string                   `json:"id,omitempty"`
+	ImageName   string                   `json:"name,omitempty"`
+	Constraints []IaaSConstraintAPIModel `json:"constraints"`
+	CloudConfig string                   `json:"cloudConfig"`
+}
+
+// ImageMappingsAPIModel describes the image mappings returned by the API.
+type ImageMappingsAPIModel struct {
+	Mapping map[string]ImageMappingAPIModel `json:"mapping"`
+}
+
+// Update the mapping from the API, the image is referenced the same way as before (by name if it
+// was referenced by name, by identifier otherwise) because the API is returning both.
+func (self *ImageMappingModel) FromAPI(
+	ctx context.Context,
+	raw ImageMappingAPIModel,
+) diag.Diagnostics {
+	if len(self.ImageName.ValueString()) > 0 {
+		self.ImageId = types.StringNull()
+		self.ImageName = types.StringValue(raw.ImageName)
+	} else {
+		self.ImageId = types.StringValue(raw.ImageId)
+		self.ImageName = types.StringNull()
+	}
+	self.CloudConfig = types.StringValue(CleanString(raw.CloudConfig))
+	var diags diag.Diagnostics
+	self.Constraints, diags = IaaSConstraintsFromAPI(ctx, raw.Constraints)
+	return diags
+}
+
+func (self ImageMappingModel) ToAPI(
+	ctx context.Context,
+) (ImageMappingAPIModel, diag.Diagnostics) {
+	constraintsRaw, diags := IaaSConstraintsToAPI(ctx, self.Constraints)
+	return ImageMappingAPIModel{
+		ImageId:     self.ImageId.ValueString(),
+		ImageName:   self.ImageName.ValueString(),
+		Constraints: constraintsRaw,
+		CloudConfig: CleanString(self.CloudConfig.ValueString()),
+	}, diags
+}
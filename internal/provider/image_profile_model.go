@@ -0,0 +1,121 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImageProfileModel describes the resource data model.
+type ImageProfileModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	RegionId    types.String `tfsdk:"region_id"`
+
+	ImageMapping map[string]ImageMappingModel `tfsdk:"image_mapping"`
+
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	CloudAccountId   types.String `tfsdk:"cloud_account_id"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// ImageProfileAPIModel describes the resource API model.
+type ImageProfileAPIModel struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RegionId    string `json:"regionId,omitempty"`
+
+	// Image mapping is sent as imageMapping, returned as imageMappings.mapping
+	ImageMapping  map[string]ImageMappingAPIModel `json:"imageMapping,omitempty"`
+	ImageMappings ImageMappingsAPIModel           `json:"imageMappings,omitzero"`
+
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	Links map[string]IaaSLinkAPIModel `json:"_links,omitempty"`
+}
+
+func (self ImageProfileModel) String() string {
+	return fmt.Sprintf(
+		"Image Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of image profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self ImageProfileModel) LockKey() string {
+	return "image-profile-" + self.Id.ValueString()
+}
+
+func (self ImageProfileModel) CreatePath() string {
+	return "iaas/api/image-profiles"
+}
+
+func (self ImageProfileModel) ReadPath() string {
+	return "iaas/api/image-profiles/" + self.Id.ValueString()
+}
+
+func (self ImageProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self ImageProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *ImageProfileModel) FromAPI(
+	ctx context.Context,
+	raw ImageProfileAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// Region is kept as is if not returned by the API
+	if regionId := IaaSRegionId(raw.RegionId, raw.Links); len(regionId) > 0 {
+		self.RegionId = types.StringValue(regionId)
+	}
+
+	// Mappings are updated in place (to reference the images the same way as before)
+	diags := diag.Diagnostics{}
+	imageMapping := map[string]ImageMappingModel{}
+	for name, mappingRaw := range raw.ImageMappings.Mapping {
+		mapping := self.ImageMapping[name]
+		diags.Append(mapping.FromAPI(ctx, mappingRaw)...)
+		imageMapping[name] = mapping
+	}
+	self.ImageMapping = imageMapping
+
+	return diags
+}
+
+func (self ImageProfileModel) ToAPI(
+	ctx context.Context,
+) (ImageProfileAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	imageMappingRaw := map[string]ImageMappingAPIModel{}
+	for name, mapping := range self.ImageMapping {
+		mappingRaw, someDiags := mapping.ToAPI(ctx)
+		diags.Append(someDiags...)
+		imageMappingRaw[name] = mappingRaw
+	}
+	return ImageProfileAPIModel{
+		Name:         self.Name.ValueString(),
+		Description:  CleanString(self.Description.ValueString()),
+		RegionId:     self.RegionId.ValueString(),
+		ImageMapping: imageMappingRaw,
+	}, diags
+}
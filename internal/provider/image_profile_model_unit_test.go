@@ -0,0 +1,77 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImageProfileModelAPI(t *testing.T) {
+	ctx := t.Context()
+	constraints, diags := IaaSConstraintsFromAPI(ctx, nil)
+	CheckDiagnostics(t, diags, "", "")
+
+	// Images are referenced by identifier or by name
+	profile := ImageProfileModel{
+		Name:     types.StringValue("Images"),
+		RegionId: types.StringValue("region-1"),
+		ImageMapping: map[string]ImageMappingModel{
+			"ubuntu": {
+				ImageId:     types.StringValue("image-1"),
+				ImageName:   types.StringNull(),
+				Constraints: constraints,
+				CloudConfig: types.StringValue("#cloud-config\n"),
+			},
+			"debian": {
+				ImageId:     types.StringNull(),
+				ImageName:   types.StringValue("debian-13"),
+				Constraints: constraints,
+				CloudConfig: types.StringValue(""),
+			},
+		},
+	}
+	raw, diags := profile.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, raw.ImageMapping["ubuntu"].ImageId, "image-1")
+	CheckEqual(t, raw.ImageMapping["debian"].ImageName, "debian-13")
+	CheckEqual(t, raw.ImageMapping["debian"].Constraints != nil, true)
+
+	// The API is returning both, images are referenced the same way as before
+	diags = profile.FromAPI(ctx, ImageProfileAPIModel{
+		Id:   "profile-1",
+		Name: "Images",
+		ImageMappings: ImageMappingsAPIModel{
+			Mapping: map[string]ImageMappingAPIModel{
+				"ubuntu": {
+					ImageId:     "image-1",
+					ImageName:   "ubuntu-24",
+					CloudConfig: "#cloud-config\n",
+					Constraints: []IaaSConstraintAPIModel{{Expression: "env:prod", Mandatory: true}},
+				},
+				"debian": {ImageId: "image-2", ImageName: "debian-13"},
+			},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, profile.ImageMapping["ubuntu"].ImageId.ValueString(), "image-1")
+	CheckEqual(t, profile.ImageMapping["ubuntu"].ImageName.IsNull(), true)
+	CheckEqual(t, len(profile.ImageMapping["ubuntu"].Constraints.Elements()), 1)
+	CheckEqual(t, profile.ImageMapping["debian"].ImageId.IsNull(), true)
+	CheckEqual(t, profile.ImageMapping["debian"].ImageName.ValueString(), "debian-13")
+	CheckEqual(t, profile.RegionId.ValueString(), "region-1")
+
+	// Imported, images are referenced by identifier
+	imported := ImageProfileModel{}
+	CheckDiagnostics(t, imported.FromAPI(ctx, ImageProfileAPIModel{
+		ImageMappings: ImageMappingsAPIModel{
+			Mapping: map[string]ImageMappingAPIModel{
+				"debian": {ImageId: "image-2", ImageName: "debian-13"},
+			},
+		},
+	}), "", "")
+	CheckEqual(t, imported.ImageMapping["debian"].ImageId.ValueString(), "image-2")
+	CheckEqual(t, imported.ImageMapping["debian"].ImageName.IsNull(), true)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+func NewImageProfileResource() resource.Resource {
+	return &GenericResource[ImageProfileModel, *ImageProfileModel, ImageProfileAPIModel]{
+		config: GenericResourceConfig{
+			TypeName:     "_image_profile",
+			SchemaFunc:   ImageProfileSchema,
+			UpdateMethod: "PATCH",
+		},
+	}
+}
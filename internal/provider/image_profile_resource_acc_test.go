@@ -0,0 +1,107 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImageProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+variable "test_image_id" {
+  description = "Image (of the region) to use for testing the image profile."
+  type        = string
+}
+
+resource "aria_image_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_IMAGE_PROFILE"
+  description = "Temporary image profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  image_mapping = {
+    ARIA_PROVIDER_TEST_IMAGE = {
+      image_id = var.test_image_id
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "id"),
+					resource.TestCheckResourceAttr(
+						"aria_image_profile.test", "name", "ARIA_PROVIDER_TEST_IMAGE_PROFILE"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aria_image_profile.test",
+						"image_mapping.ARIA_PROVIDER_TEST_IMAGE.constraints.#", "0"),
+					resource.TestCheckResourceAttr(
+						"aria_image_profile.test",
+						"image_mapping.ARIA_PROVIDER_TEST_IMAGE.cloud_config", ""),
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "org_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+variable "test_image_id" {
+  description = "Image (of the region) to use for testing the image profile."
+  type        = string
+}
+
+resource "aria_image_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_IMAGE_PROFILE"
+  description = "Temporary image profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  image_mapping = {
+    ARIA_PROVIDER_TEST_IMAGE = {
+      image_id     = var.test_image_id
+      cloud_config = <<EOT
+#cloud-config
+hostname: test
+EOT
+      constraints = [
+        { expression = "ARIA_PROVIDER_TEST:image", mandatory = false }
+      ]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aria_image_profile.test",
+						"image_mapping.ARIA_PROVIDER_TEST_IMAGE.constraints.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aria_image_profile.test",
+						"image_mapping.ARIA_PROVIDER_TEST_IMAGE.cloud_config",
+						"#cloud-config\nhostname: test\n"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_image_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ImageProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Image profile resource, map the images to templates (or AMIs, ...) " +
+			"in a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"description": RequiredDescriptionSchema(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_mapping": schema.MapNestedAttribute{
+				MarkdownDescription: "Images, image name (e.g. `ubuntu-24`) to the image of the " +
+					"region (template, AMI, ...)",
+				Required: true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"image_id": schema.StringAttribute{
+							MarkdownDescription: "Image identifier (either image_id or image_name " +
+								"must be set)",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("image_name")),
+							},
+						},
+						"image_name": schema.StringAttribute{
+							MarkdownDescription: "Image name on the cloud provider side " +
+								"(either image_id or image_name must be set)",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"constraints": ProjectConstraintListSchema(
+							"Constraints, to select the image based on the tags of the computes"),
+						"cloud_config": schema.StringAttribute{
+							MarkdownDescription: "Cloud config (cloud-init) applied to the machines " +
+								"provisioned with this image (defaults to an empty string)",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"external_region_id": ComputedIdentifierSchema(
+				"Identifier of the region on the cloud provider side"),
+			"cloud_account_id": ComputedIdentifierSchema("Cloud account identifier"),
+			"org_id":           ComputedOrganizationIdSchema(),
+		},
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkProfileModel describes the resource data model.
+type NetworkProfileModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	RegionId    types.String `tfsdk:"region_id"`
+
+	IsolationType                    types.String `tfsdk:"isolation_type"`
+	IsolationNetworkDomainCIDR       types.String `tfsdk:"isolation_network_domain_cidr"`
+	IsolatedNetworkCIDRPrefix        types.Int32  `tfsdk:"isolated_network_cidr_prefix"`
+	IsolationExternalFabricNetworkId types.String `tfsdk:"isolation_external_fabric_network_id"`
+
+	// Of type string
+	FabricNetworkIds types.Set `tfsdk:"fabric_network_ids"`
+	SecurityGroupIds types.Set `tfsdk:"security_group_ids"`
+	LoadBalancerIds  types.Set `tfsdk:"load_balancer_ids"`
+
+	CustomProperties types.Map `tfsdk:"custom_properties"`
+	Tags             types.Set `tfsdk:"tags"` // Of type IaaSTagModel
+
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	CloudAccountId   types.String `tfsdk:"cloud_account_id"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// NetworkProfileAPIModel describes the resource API model.
+type NetworkProfileAPIModel struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RegionId    string `json:"regionId,omitempty"`
+
+	IsolationType                    string `json:"isolationType"`
+	IsolationNetworkDomainCIDR       string `json:"isolationNetworkDomainCIDR,omitempty"`
+	IsolatedNetworkCIDRPrefix        int32  `json:"isolatedNetworkCIDRPrefix,omitempty"`
+	IsolationExternalFabricNetworkId string `json:"isolationExternalFabricNetworkId,omitempty"`
+
+	// Sent as identifiers, returned as links
+	FabricNetworkIds []string `json:"fabricNetworkIds"`
+	SecurityGroupIds []string `json:"securityGroupIds"`
+	LoadBalancerIds  []string `json:"loadBalancerIds"`
+
+	CustomProperties map[string]string `json:"customProperties"`
+	Tags             []TagAPIModel     `json:"tags"`
+
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	Links map[string]IaaSLinkAPIModel `json:"_links,omitempty"`
+}
+
+func (self NetworkProfileModel) String() string {
+	return fmt.Sprintf(
+		"Network Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of network profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self NetworkProfileModel) LockKey() string {
+	return "network-profile-" + self.Id.ValueString()
+}
+
+func (self NetworkProfileModel) CreatePath() string {
+	return "iaas/api/network-profiles"
+}
+
+func (self NetworkProfileModel) ReadPath() string {
+	return "iaas/api/network-profiles/" + self.Id.ValueString()
+}
+
+func (self NetworkProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self NetworkProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *NetworkProfileModel) FromAPI(
+	ctx context.Context,
+	raw NetworkProfileAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.IsolationType = types.StringValue(raw.IsolationType)
+	self.IsolationNetworkDomainCIDR = types.StringValue(raw.IsolationNetworkDomainCIDR)
+	self.IsolatedNetworkCIDRPrefix = types.Int32Value(raw.IsolatedNetworkCIDRPrefix)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// Region is kept as is if not returned by the API
+	if regionId := IaaSRegionId(raw.RegionId, raw.Links); len(regionId) > 0 {
+		self.RegionId = types.StringValue(regionId)
+	}
+
+	self.IsolationExternalFabricNetworkId = types.StringValue(
+		IaaSLinkId(raw.Links, "isolated-external-fabric-networks"))
+
+	diags := diag.Diagnostics{}
+	var someDiags diag.Diagnostics
+
+	self.FabricNetworkIds, someDiags = types.SetValueFrom(
+		ctx, types.StringType, IaaSLinkIds(raw.Links, "fabric-networks"))
+	diags.Append(someDiags...)
+	self.SecurityGroupIds, someDiags = types.SetValueFrom(
+		ctx, types.StringType, IaaSLinkIds(raw.Links, "security-groups"))
+	diags.Append(someDiags...)
+	self.LoadBalancerIds, someDiags = types.SetValueFrom(
+		ctx, types.StringType, IaaSLinkIds(raw.Links, "load-balancers"))
+	diags.Append(someDiags...)
+
+	self.CustomProperties, someDiags = types.MapValueFrom(
+		ctx, types.StringType, raw.CustomProperties)
+	diags.Append(someDiags...)
+	self.Tags, someDiags = IaaSTagsFromAPI(ctx, raw.Tags)
+	diags.Append(someDiags...)
+
+	return diags
+}
+
+func (self NetworkProfileModel) ToAPI(
+	ctx context.Context,
+) (NetworkProfileAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	fabricNetworkIdsRaw := make([]string, 0, len(self.FabricNetworkIds.Elements()))
+	diags.Append(self.FabricNetworkIds.ElementsAs(ctx, &fabricNetworkIdsRaw, false)...)
+	securityGroupIdsRaw := make([]string, 0, len(self.SecurityGroupIds.Elements()))
+	diags.Append(self.SecurityGroupIds.ElementsAs(ctx, &securityGroupIdsRaw, false)...)
+	loadBalancerIdsRaw := make([]string, 0, len(self.LoadBalancerIds.Elements()))
+	diags.Append(self.LoadBalancerIds.ElementsAs(ctx, &loadBalancerIdsRaw, false)...)
+
+	customPropertiesRaw := make(map[string]string, len(self.CustomProperties.Elements()))
+	diags.Append(self.CustomProperties.ElementsAs(ctx, &customPropertiesRaw, false)...)
+	tagsRaw, someDiags := IaaSTagsToAPI(ctx, self.Tags)
+	diags.Append(someDiags...)
+
+	return NetworkProfileAPIModel{
+		Name:                             self.Name.ValueString(),
+		Description:                      CleanString(self.Description.ValueString()),
+		RegionId:                         self.RegionId.ValueString(),
+		IsolationType:                    self.IsolationType.ValueString(),
+		IsolationNetworkDomainCIDR:       self.IsolationNetworkDomainCIDR.ValueString(),
+		IsolatedNetworkCIDRPrefix:        self.IsolatedNetworkCIDRPrefix.ValueInt32(),
+		IsolationExternalFabricNetworkId: self.IsolationExternalFabricNetworkId.ValueString(),
+		FabricNetworkIds:                 fabricNetworkIdsRaw,
+		SecurityGroupIds:                 securityGroupIdsRaw,
+		LoadBalancerIds:                  loadBalancerIdsRaw,
+		CustomProperties:                 customPropertiesRaw,
+		Tags:                             tagsRaw,
+	}, diags
+}
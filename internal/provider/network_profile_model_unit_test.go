@@ -0,0 +1,47 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNetworkProfileModelAPI(t *testing.T) {
+	ctx := t.Context()
+	profile := NetworkProfileModel{}
+	diags := profile.FromAPI(ctx, NetworkProfileAPIModel{
+		Id:                         "profile-1",
+		Name:                       "Networks",
+		IsolationType:              "SUBNET",
+		IsolationNetworkDomainCIDR: "10.10.0.0/16",
+		IsolatedNetworkCIDRPrefix:  24,
+		Tags:                       []TagAPIModel{{Key: "env", Value: "prod"}},
+		Links: map[string]IaaSLinkAPIModel{
+			"region": {Href: "/iaas/api/regions/region-1"},
+			"fabric-networks": {Hrefs: []string{
+				"/iaas/api/fabric-networks/network-1",
+				"/iaas/api/fabric-networks/network-2",
+			}},
+			"security-groups":                   {Hrefs: []string{"/iaas/api/security-groups/group-1"}},
+			"isolated-external-fabric-networks": {Href: "/iaas/api/fabric-networks/network-3"},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, profile.RegionId.ValueString(), "region-1")
+	CheckEqual(t, profile.IsolationExternalFabricNetworkId.ValueString(), "network-3")
+	CheckEqual(t, len(profile.FabricNetworkIds.Elements()), 2)
+	CheckEqual(t, len(profile.SecurityGroupIds.Elements()), 1)
+	CheckEqual(t, len(profile.LoadBalancerIds.Elements()), 0)
+	CheckEqual(t, len(profile.CustomProperties.Elements()), 0)
+
+	// Identifiers are sent even if empty (to remove them all)
+	raw, diags := profile.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, raw.RegionId, "region-1")
+	CheckEqual(t, raw.IsolationExternalFabricNetworkId, "network-3")
+	CheckEqual(t, len(raw.FabricNetworkIds), 2)
+	CheckDeepEqual(t, raw.SecurityGroupIds, []string{"group-1"})
+	CheckEqual(t, raw.LoadBalancerIds != nil && len(raw.LoadBalancerIds) == 0, true)
+	CheckDeepEqual(t, raw.Tags, []TagAPIModel{{Key: "env", Value: "prod"}})
+}
@@ -0,0 +1,16 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+func NewNetworkProfileResource() resource.Resource {
+	return &GenericResource[NetworkProfileModel, *NetworkProfileModel, NetworkProfileAPIModel]{
+		config: GenericResourceConfig{
+			TypeName:     "_network_profile",
+			SchemaFunc:   NetworkProfileSchema,
+			UpdateMethod: "PATCH",
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_network_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_NETWORK_PROFILE"
+  description = "Temporary network profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_network_profile.test", "id"),
+					resource.TestCheckResourceAttr(
+						"aria_network_profile.test", "name", "ARIA_PROVIDER_TEST_NETWORK_PROFILE"),
+					resource.TestCheckResourceAttr("aria_network_profile.test", "isolation_type", "NONE"),
+					resource.TestCheckResourceAttr("aria_network_profile.test", "fabric_network_ids.#", "0"),
+					resource.TestCheckResourceAttr("aria_network_profile.test", "tags.#", "0"),
+					resource.TestCheckResourceAttrSet("aria_network_profile.test", "org_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+variable "test_fabric_network_id" {
+  description = "Fabric network (of the region) to use for testing the network profile."
+  type        = string
+}
+
+data "aria_fabric_network" "test" {
+  id = var.test_fabric_network_id
+}
+
+resource "aria_network_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_NETWORK_PROFILE"
+  description = "Temporary network profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+
+  fabric_network_ids = [data.aria_fabric_network.test.id]
+
+  tags = [
+    { key = "ARIA_PROVIDER_TEST_NETWORK_PROFILE", value = "network" }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_network_profile.test", "fabric_network_ids.#", "1"),
+					resource.TestCheckResourceAttr("aria_network_profile.test", "tags.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_network_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
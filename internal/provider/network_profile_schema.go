@@ -0,0 +1,86 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var NETWORK_PROFILE_ISOLATION_TYPES = []string{"NONE", "SUBNET", "SECURITY_GROUP"}
+
+func NetworkProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Network profile resource, networks and isolation policy of a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"description": RequiredDescriptionSchema(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"isolation_type": schema.StringAttribute{
+				MarkdownDescription: "Isolation policy, either `NONE` (the default), " +
+					"`SUBNET` (on-demand networks) or `SECURITY_GROUP` (on-demand security groups)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("NONE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(NETWORK_PROFILE_ISOLATION_TYPES...),
+				},
+			},
+			"isolation_network_domain_cidr": schema.StringAttribute{
+				MarkdownDescription: "CIDR of the network domain from which the on-demand networks " +
+					"are allocated, e.g. `10.10.0.0/16` (isolation by subnet, " +
+					"defaults to an empty string)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"isolated_network_cidr_prefix": schema.Int32Attribute{
+				MarkdownDescription: "CIDR prefix of the on-demand networks, e.g. `24` " +
+					"(isolation by subnet, defaults to 0)",
+				Optional: true,
+				Computed: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.Between(0, 32),
+				},
+			},
+			"isolation_external_fabric_network_id": schema.StringAttribute{
+				MarkdownDescription: "External fabric network the on-demand networks are " +
+					"connected to (isolation by subnet, defaults to an empty string)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"fabric_network_ids": IaaSIdentifiersSchema(
+				"Fabric networks of the profile (see the `aria_fabric_network` data source)"),
+			"security_group_ids": IaaSIdentifiersSchema(
+				"Security groups applied to the machines provisioned with the profile"),
+			"load_balancer_ids": IaaSIdentifiersSchema(
+				"Load balancers available to the machines provisioned with the profile"),
+			"custom_properties": IaaSCustomPropertiesSchema(
+				"Custom properties attached to the networks provisioned with the profile"),
+			"tags": IaaSTagsSchema("Tags of the network profile"),
+			"external_region_id": ComputedIdentifierSchema(
+				"Identifier of the region on the cloud provider side"),
+			"cloud_account_id": ComputedIdentifierSchema("Cloud account identifier"),
+			"org_id":           ComputedOrganizationIdSchema(),
+		},
+	}
+}
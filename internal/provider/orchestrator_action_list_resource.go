@@ -0,0 +1,29 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewOrchestratorActionListResource() list.ListResource {
+	return &GenericListResource{config: OrchestratorActionListResourceConfig()}
+}
+
+func OrchestratorActionListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:     "_orchestrator_action",
+		NewResource:  NewOrchestratorActionResource,
+		ListPath:     "vco/api/actions",
+		Format:       LIST_FORMAT_VRO,
+		DisplayField: "fqn",
+		Filters: []ListFilter{
+			{
+				Attribute:   "module",
+				Description: "List only the actions of this module (e.g. `com.vmware.library.vc`)",
+				Field:       "module",
+			},
+		},
+		ImportKeyFields:    []string{"fqn"},
+		ImportKeySeparator: "/",
+	}
+}
@@ -93,6 +93,10 @@ func (self OrchestratorActionModel) DeletePath() string {
 	return self.ReadPath()
 }
 
+func (self OrchestratorActionModel) IsForceDelete() bool {
+	return self.ForceDelete.ValueBool()
+}
+
 func (self *OrchestratorActionModel) FromAPI(
 	ctx context.Context,
 	raw OrchestratorActionAPIModel,
@@ -14,7 +14,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorActionResource{}
+var _ resource.ResourceWithIdentity = &OrchestratorActionResource{}
 var _ resource.ResourceWithImportState = &OrchestratorActionResource{}
+var _ resource.ResourceWithUpgradeState = &OrchestratorActionResource{}
 
 func NewOrchestratorActionResource() resource.Resource {
 	return &OrchestratorActionResource{}
@@ -41,6 +43,18 @@ func (self *OrchestratorActionResource) Schema(
 	resp.Schema = OrchestratorActionSchema()
 }
 
+func (self *OrchestratorActionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(OrchestratorActionSchema().Version)
+}
+
+func (self *OrchestratorActionResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *OrchestratorActionResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -79,6 +93,7 @@ func (self *OrchestratorActionResource) Create(
 	// Save action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", action.String()))
 }
 
@@ -112,6 +127,7 @@ func (self *OrchestratorActionResource) Read(
 	// Save updated action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *OrchestratorActionResource) Update(
@@ -157,6 +173,7 @@ func (self *OrchestratorActionResource) Update(
 	// Save updated action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", action.String()))
 }
 
@@ -180,6 +197,6 @@ func (self *OrchestratorActionResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrNaturalKey(ctx, self.client, OrchestratorActionListResourceConfig(), req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_delete"), false)...)
 }
@@ -21,6 +21,8 @@ func OrchestratorActionSchema() schema.Schema {
 			"encounter 409 conflicts. The provider implements a convergence algorithm that retries " +
 			"conflicting deletions up to 60 times with a 3-second delay (180 seconds total), " +
 			"expecting dependent actions to be removed concurrently. " +
+			"Retrying stops earlier when the blocking references reported by the API do not " +
+			"change for 60 seconds, those references are listed in the error message. " +
 			"This worked on previous runs but has been consistently failing since March 2026 on " +
 			"our infrastructure, possibly due to a vRO API regression where the \"in use\" state " +
 			"is not cleared after dependent actions are deleted. If deletion fails, set " +
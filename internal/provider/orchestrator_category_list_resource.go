@@ -0,0 +1,25 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewOrchestratorCategoryListResource() list.ListResource {
+	return &GenericListResource{
+		config: GenericListResourceConfig{
+			TypeName:    "_orchestrator_category",
+			NewResource: NewOrchestratorCategoryResource,
+			ListPath:    "vco/api/categories",
+			Format:      LIST_FORMAT_VRO,
+			Filters: []ListFilter{
+				{
+					Attribute:   "type",
+					Description: "List only the categories of this type (e.g. `WorkflowCategory`)",
+					Field:       "type",
+					QueryParam:  "categoryType",
+				},
+			},
+		},
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorCategoryResource{}
+var _ resource.ResourceWithIdentity = &OrchestratorCategoryResource{}
 var _ resource.ResourceWithImportState = &OrchestratorCategoryResource{}
+var _ resource.ResourceWithUpgradeState = &OrchestratorCategoryResource{}
 
 func NewOrchestratorCategoryResource() resource.Resource {
 	return &OrchestratorCategoryResource{}
@@ -41,6 +43,18 @@ func (self *OrchestratorCategoryResource) Schema(
 	resp.Schema = OrchestratorCategorySchema()
 }
 
+func (self *OrchestratorCategoryResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(OrchestratorCategorySchema().Version)
+}
+
+func (self *OrchestratorCategoryResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *OrchestratorCategoryResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -71,6 +85,7 @@ func (self *OrchestratorCategoryResource) Create(
 	// Save category into Terraform state
 	category.FromAPI(categoryFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &category)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", category.String()))
 }
 
@@ -101,6 +116,7 @@ func (self *OrchestratorCategoryResource) Read(
 	// Save updated category into Terraform state
 	category.FromAPI(categoryFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &category)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *OrchestratorCategoryResource) Update(
@@ -136,6 +152,7 @@ func (self *OrchestratorCategoryResource) Update(
 	// Save updated category into Terraform state
 	category.FromAPI(categoryFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &category)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", category.String()))
 }
 
@@ -157,5 +174,5 @@ func (self *OrchestratorCategoryResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
 }
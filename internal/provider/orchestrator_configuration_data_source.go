@@ -71,5 +71,6 @@ func (self *OrchestratorConfigurationDataSource) Read(
 
 	// Save updated configuration into Terraform state
 	resp.Diagnostics.Append(configuration.FromAPI(ctx, configurationRaw, response)...)
+	resp.Diagnostics.Append(configuration.DropWriteOnlyAttributes(ctx)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &configuration)...)
 }
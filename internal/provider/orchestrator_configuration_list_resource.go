@@ -0,0 +1,29 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewOrchestratorConfigurationListResource() list.ListResource {
+	return &GenericListResource{config: OrchestratorConfigurationListResourceConfig()}
+}
+
+func OrchestratorConfigurationListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_orchestrator_configuration",
+		NewResource: NewOrchestratorConfigurationResource,
+		ListPath:    "vco/api/configurations",
+		Format:      LIST_FORMAT_VRO,
+		Filters: []ListFilter{
+			{
+				Attribute:   "category_id",
+				Description: "List only the configurations of this category",
+				Field:       "categoryId",
+			},
+		},
+		ImportKeyFields:    []string{"categoryPath", "name"},
+		ImportKeySeparator: "/",
+		CategoryType:       "ConfigurationElementCategory",
+	}
+}
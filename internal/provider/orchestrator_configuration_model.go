@@ -9,7 +9,10 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // OrchestratorConfigurationModel describes the resource data model.
@@ -77,6 +80,10 @@ func (self OrchestratorConfigurationModel) DeletePath() string {
 	return path
 }
 
+func (self OrchestratorConfigurationModel) IsForceDelete() bool {
+	return self.ForceDelete.ValueBool()
+}
+
 func (self *OrchestratorConfigurationDataSourceModel) FromAPI(
 	ctx context.Context,
 	raw OrchestratorConfigurationAPIModel,
@@ -146,3 +153,76 @@ func (self OrchestratorConfigurationDataSourceModel) ToAPI(
 		Attributes:  attributesRaw,
 	}, diags
 }
+
+// Drop the write-only attributes of the secure strings (not exposed by the data source).
+func (self *OrchestratorConfigurationDataSourceModel) DropWriteOnlyAttributes(
+	ctx context.Context,
+) diag.Diagnostics {
+	attributesType := OrchestratorConfigurationDataSourceSchema().Attributes["attributes"].GetType()
+	value, diags := ConvertToType(ctx, self.Attributes, attributesType)
+	if list, ok := value.(basetypes.ListValue); ok {
+		self.Attributes = list
+	}
+	return diags
+}
+
+// Retrieve the write-only values of the secure strings from the configuration.
+func (self *OrchestratorConfigurationModel) FromConfig(
+	ctx context.Context,
+	config tfsdk.Config,
+) diag.Diagnostics {
+	var attributes types.List
+	diags := config.GetAttribute(ctx, path.Root("attributes"), &attributes)
+	if diags.HasError() {
+		return diags
+	}
+	value, someDiags := TransformObjectsWithAttribute(
+		ctx, self.Attributes, attributes, "value_wo",
+		func(target basetypes.ObjectValue, source basetypes.ObjectValue) basetypes.ObjectValue {
+			return withSecureStringAttribute(ctx, target, source, "value_wo")
+		})
+	diags.Append(someDiags...)
+	if list, ok := value.(basetypes.ListValue); ok {
+		self.Attributes = list
+	}
+	return diags
+}
+
+// Prevent the write-only values of the secure strings from being stored in the state.
+// The API returns the secrets, the value is kept only if the reference (plan or prior state)
+// has a value. The version of the write-only value is retrieved from the reference.
+func (self *OrchestratorConfigurationModel) HideWriteOnlyValues(
+	ctx context.Context,
+	reference OrchestratorConfigurationModel,
+) diag.Diagnostics {
+	value, diags := TransformObjectsWithAttribute(
+		ctx, self.Attributes, reference.Attributes, "value_wo",
+		func(target basetypes.ObjectValue, source basetypes.ObjectValue) basetypes.ObjectValue {
+			if source.IsNull() {
+				return target
+			}
+			if source.Attributes()["value"].IsNull() {
+				target = withSecureStringAttribute(ctx, target, source, "value")
+			}
+			return withSecureStringAttribute(ctx, target, source, "value_wo_version")
+		})
+	if list, ok := value.(basetypes.ListValue); ok {
+		self.Attributes = list
+	}
+	return diags
+}
+
+// Return a copy of the target secure string with the given attribute of the source.
+func withSecureStringAttribute(
+	ctx context.Context,
+	target basetypes.ObjectValue,
+	source basetypes.ObjectValue,
+	name string,
+) basetypes.ObjectValue {
+	if target.IsNull() || source.IsNull() {
+		return target
+	}
+	attributes := target.Attributes()
+	attributes[name] = source.Attributes()[name]
+	return basetypes.NewObjectValueMust(target.AttributeTypes(ctx), attributes)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func secureConfigurationFromAPI(t *testing.T, secret string) OrchestratorConfigurationModel {
+	t.Helper()
+	configuration := OrchestratorConfigurationModel{}
+	raw := OrchestratorConfigurationAPIModel{
+		Id:   "config-1",
+		Name: "Secrets",
+		Attributes: []OrchestratorConfigurationAttributeAPIModel{
+			{
+				Name: "password",
+				Type: "SecureString",
+				Value: OrchestratorConfigurationAttributeValueAPIModel{
+					SecureString: &OrchestratorConfigurationSecureStringAPIModel{Value: secret},
+				},
+			},
+		},
+	}
+	diags := configuration.FromAPI(t.Context(), raw, &resty.Response{})
+	CheckDiagnostics(t, diags, "", "")
+	return configuration
+}
+
+// Return the secure string of the first attribute.
+func firstSecureString(t *testing.T, configuration OrchestratorConfigurationModel) basetypes.ObjectValue {
+	t.Helper()
+	attribute, ok := configuration.Attributes.Elements()[0].(basetypes.ObjectValue)
+	if !ok {
+		t.Fatalf("attribute is not an object")
+	}
+	value, ok := attribute.Attributes()["value"].(basetypes.ObjectValue)
+	if !ok {
+		t.Fatalf("attribute value is not an object")
+	}
+	secure, ok := value.Attributes()["secure_string"].(basetypes.ObjectValue)
+	if !ok {
+		t.Fatalf("secure string is not an object")
+	}
+	return secure
+}
+
+func TestOrchestratorConfigurationSecureStringToAPIPrefersWriteOnly(t *testing.T) {
+	secure := OrchestratorConfigurationSecureStringModel{
+		Value:   types.StringNull(),
+		ValueWO: types.StringValue("s3cr3t"),
+	}
+	CheckEqual(t, secure.ToAPI().Value, "s3cr3t")
+
+	secure = OrchestratorConfigurationSecureStringModel{
+		Value:   types.StringValue("plain"),
+		ValueWO: types.StringNull(),
+	}
+	CheckEqual(t, secure.ToAPI().Value, "plain")
+}
+
+func TestOrchestratorConfigurationHideWriteOnlyValues(t *testing.T) {
+	ctx := t.Context()
+
+	// Plan: value_wo is used instead of value
+	plan := secureConfigurationFromAPI(t, "s3cr3t")
+	value, diags := TransformObjectsWithAttribute(
+		ctx, plan.Attributes, plan.Attributes, "value_wo",
+		func(target basetypes.ObjectValue, source basetypes.ObjectValue) basetypes.ObjectValue {
+			attributes := target.Attributes()
+			attributes["value"] = types.StringNull()
+			attributes["value_wo_version"] = types.Int64Value(2)
+			return basetypes.NewObjectValueMust(target.AttributeTypes(ctx), attributes)
+		})
+	CheckDiagnostics(t, diags, "", "")
+	list, ok := value.(basetypes.ListValue)
+	if !ok {
+		t.Fatalf("transformed attributes is not a list")
+	}
+	plan.Attributes = list
+
+	// API returns the secret, it must not be stored in the state
+	configuration := secureConfigurationFromAPI(t, "s3cr3t")
+	CheckDiagnostics(t, configuration.HideWriteOnlyValues(ctx, plan), "", "")
+	secure := firstSecureString(t, configuration)
+	CheckEqual(t, secure.Attributes()["value"].IsNull(), true)
+	CheckEqual(t, secure.Attributes()["value_wo"].IsNull(), true)
+	CheckEqual(t, secure.Attributes()["value_wo_version"], types.Int64Value(2))
+
+	// Value is kept when set in the reference
+	configuration = secureConfigurationFromAPI(t, "s3cr3t")
+	reference := secureConfigurationFromAPI(t, "s3cr3t")
+	CheckDiagnostics(t, configuration.HideWriteOnlyValues(ctx, reference), "", "")
+	secure = firstSecureString(t, configuration)
+	CheckEqual(t, secure.Attributes()["value"], types.StringValue("s3cr3t"))
+
+	// Value is kept when there is no reference (e.g. import)
+	configuration = secureConfigurationFromAPI(t, "s3cr3t")
+	diags = configuration.HideWriteOnlyValues(ctx, OrchestratorConfigurationModel{})
+	CheckDiagnostics(t, diags, "", "")
+	secure = firstSecureString(t, configuration)
+	CheckEqual(t, secure.Attributes()["value"], types.StringValue("s3cr3t"))
+}
+
+func TestOrchestratorConfigurationDropWriteOnlyAttributes(t *testing.T) {
+	ctx := t.Context()
+	configuration := secureConfigurationFromAPI(t, "s3cr3t")
+	CheckDiagnostics(t, configuration.DropWriteOnlyAttributes(ctx), "", "")
+
+	secure := firstSecureString(t, configuration)
+	CheckEqual(t, secure.Attributes()["value"].String(), `"s3cr3t"`)
+	CheckEqual(t, secure.Attributes()["value_wo"] == nil, true)
+	CheckEqual(t, secure.Attributes()["value_wo_version"] == nil, true)
+
+	// Matches the schema of the data source
+	schema := OrchestratorConfigurationDataSourceSchema()
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+	CheckDiagnostics(t, state.Set(ctx, &configuration.OrchestratorConfigurationDataSourceModel), "", "")
+}
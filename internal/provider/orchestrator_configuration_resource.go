@@ -7,14 +7,15 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorConfigurationResource{}
+var _ resource.ResourceWithIdentity = &OrchestratorConfigurationResource{}
 var _ resource.ResourceWithImportState = &OrchestratorConfigurationResource{}
+var _ resource.ResourceWithUpgradeState = &OrchestratorConfigurationResource{}
 
 func NewOrchestratorConfigurationResource() resource.Resource {
 	return &OrchestratorConfigurationResource{}
@@ -41,6 +42,18 @@ func (self *OrchestratorConfigurationResource) Schema(
 	resp.Schema = OrchestratorConfigurationSchema()
 }
 
+func (self *OrchestratorConfigurationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(OrchestratorConfigurationSchema().Version)
+}
+
+func (self *OrchestratorConfigurationResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *OrchestratorConfigurationResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -57,9 +70,11 @@ func (self *OrchestratorConfigurationResource) Create(
 	// Read Terraform plan data into the model
 	var configuration OrchestratorConfigurationModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &configuration)...)
+	resp.Diagnostics.Append(configuration.FromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan := configuration
 
 	configurationToAPI, diags := configuration.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
@@ -80,7 +95,9 @@ func (self *OrchestratorConfigurationResource) Create(
 
 	// Save configuration into Terraform state
 	resp.Diagnostics.Append(configuration.FromAPI(ctx, configurationFromAPI, response)...)
+	resp.Diagnostics.Append(configuration.HideWriteOnlyValues(ctx, plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &configuration)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", configuration.String()))
 }
 
@@ -109,8 +126,11 @@ func (self *OrchestratorConfigurationResource) Read(
 	}
 
 	// Save updated configuration into Terraform state
+	state := configuration
 	resp.Diagnostics.Append(configuration.FromAPI(ctx, configurationFromAPI, response)...)
+	resp.Diagnostics.Append(configuration.HideWriteOnlyValues(ctx, state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &configuration)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *OrchestratorConfigurationResource) Update(
@@ -121,9 +141,11 @@ func (self *OrchestratorConfigurationResource) Update(
 	// Read Terraform plan data into the model
 	var configuration OrchestratorConfigurationModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &configuration)...)
+	resp.Diagnostics.Append(configuration.FromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan := configuration
 
 	// Read Terraform state data into the model
 	var configurationFromState OrchestratorConfigurationModel
@@ -154,7 +176,9 @@ func (self *OrchestratorConfigurationResource) Update(
 
 	// Save updated configuration into Terraform state
 	resp.Diagnostics.Append(configuration.FromAPI(ctx, configurationToAPI, response)...)
+	resp.Diagnostics.Append(configuration.HideWriteOnlyValues(ctx, plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &configuration)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", configuration.String()))
 }
 
@@ -176,5 +200,5 @@ func (self *OrchestratorConfigurationResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrNaturalKey(ctx, self.client, OrchestratorConfigurationListResourceConfig(), req, resp)
 }
@@ -10,8 +10,10 @@ import (
 
 // OrchestratorConfigurationSecureStringModel describes the resource data model.
 type OrchestratorConfigurationSecureStringModel struct {
-	Value       types.String `tfsdk:"value"`
-	IsPlainText types.Bool   `tfsdk:"is_plain_text"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	IsPlainText    types.Bool   `tfsdk:"is_plain_text"`
 }
 
 // OrchestratorConfigurationSecureStringAPIModel describes the resource API model.
@@ -24,12 +26,18 @@ func (self *OrchestratorConfigurationSecureStringModel) FromAPI(
 	raw OrchestratorConfigurationSecureStringAPIModel,
 ) {
 	self.Value = types.StringValue(raw.Value)
+	self.ValueWO = types.StringNull()
+	self.ValueWOVersion = types.Int64Null()
 	self.IsPlainText = types.BoolValue(raw.IsPlainText)
 }
 
 func (self OrchestratorConfigurationSecureStringModel) ToAPI() OrchestratorConfigurationSecureStringAPIModel {
+	value := self.Value
+	if !self.ValueWO.IsNull() {
+		value = self.ValueWO
+	}
 	return OrchestratorConfigurationSecureStringAPIModel{
-		Value:       self.Value.ValueString(),
+		Value:       value.ValueString(),
 		IsPlainText: self.IsPlainText.ValueBool(),
 	}
 }
@@ -39,7 +47,9 @@ func (self OrchestratorConfigurationSecureStringModel) ToAPI() OrchestratorConfi
 // Used to convert structure to a types.Object.
 func (self OrchestratorConfigurationSecureStringModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"value":         types.StringType,
-		"is_plain_text": types.BoolType,
+		"value":            types.StringType,
+		"value_wo":         types.StringType,
+		"value_wo_version": types.Int64Type,
+		"is_plain_text":    types.BoolType,
 	}
 }
@@ -4,7 +4,11 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // A Secure String embedded inside an Orchestrator Configuration Value.
@@ -14,9 +18,27 @@ func OrchestratorConfigurationSecureStringSchema() schema.SingleNestedAttribute
 		Optional:            true,
 		Attributes: map[string]schema.Attribute{
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "Value, prefer `value_wo` to prevent storing the value in " +
+					"the state",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Value (write-only, never stored in the state, requires " +
+					"Terraform 1.11+), update `value_wo_version` to apply a new value",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `value_wo`, change it to update the value",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+				},
 			},
 			"is_plain_text": schema.BoolAttribute{
 				MarkdownDescription: "Plain text?",
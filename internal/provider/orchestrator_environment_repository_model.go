@@ -4,8 +4,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,6 +22,10 @@ type OrchestratorEnvironmentRepositoryModel struct {
 	BasicAuth         types.Bool   `tfsdk:"basic_auth"`
 	SystemUser        types.String `tfsdk:"system_user"`
 	SystemCredentials types.String `tfsdk:"system_credentials"`
+
+	// Write-only, only available in the configuration
+	SystemCredentialsWO        types.String `tfsdk:"system_credentials_wo"`
+	SystemCredentialsWOVersion types.Int64  `tfsdk:"system_credentials_wo_version"`
 }
 
 // OrchestratorEnvironmentRepositoryAPIModel describes the resource API model.
@@ -76,8 +84,19 @@ func (self *OrchestratorEnvironmentRepositoryModel) FromAPI(
 	// self.SystemCredentials = types.StringValue("")
 }
 
+// Retrieve the write-only attributes from the configuration.
+func (self *OrchestratorEnvironmentRepositoryModel) FromConfig(
+	ctx context.Context,
+	config tfsdk.Config,
+) diag.Diagnostics {
+	return config.GetAttribute(ctx, path.Root("system_credentials_wo"), &self.SystemCredentialsWO)
+}
+
 func (self OrchestratorEnvironmentRepositoryModel) ToAPI() OrchestratorEnvironmentRepositoryAPIModel {
 	self.BasicAuth = types.BoolValue(len(self.SystemUser.ValueString()) > 0)
+	if !self.SystemCredentialsWO.IsNull() {
+		self.SystemCredentials = self.SystemCredentialsWO
+	}
 	return OrchestratorEnvironmentRepositoryAPIModel{
 		Id:                self.Id.ValueString(),
 		Name:              self.Name.ValueString(),
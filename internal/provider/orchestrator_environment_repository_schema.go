@@ -4,8 +4,12 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func OrchestratorEnvironmentRepositorySchema() schema.Schema {
@@ -36,11 +40,31 @@ func OrchestratorEnvironmentRepositorySchema() schema.Schema {
 				Default:             stringdefault.StaticString(""),
 			},
 			"system_credentials": schema.StringAttribute{
-				MarkdownDescription: "Credentials for basic authentication",
-				Computed:            true,
-				Optional:            true,
-				Sensitive:           true,
-				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Credentials for basic authentication, prefer " +
+					"`system_credentials_wo` to prevent storing the credentials in the state",
+				Computed:  true,
+				Optional:  true,
+				Sensitive: true,
+				Default:   stringdefault.StaticString(""),
+			},
+			"system_credentials_wo": schema.StringAttribute{
+				MarkdownDescription: "Credentials for basic authentication (write-only, never " +
+					"stored in the state, requires Terraform 1.11+), update " +
+					"`system_credentials_wo_version` to apply new credentials",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("system_credentials")),
+				},
+			},
+			"system_credentials_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `system_credentials_wo`, change it to update " +
+					"the credentials",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("system_credentials_wo")),
+				},
 			},
 		},
 	}
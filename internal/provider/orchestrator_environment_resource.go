@@ -16,7 +16,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorEnvironmentResource{}
+var _ resource.ResourceWithIdentity = &OrchestratorEnvironmentResource{}
 var _ resource.ResourceWithImportState = &OrchestratorEnvironmentResource{}
+var _ resource.ResourceWithUpgradeState = &OrchestratorEnvironmentResource{}
 
 func NewOrchestratorEnvironmentResource() resource.Resource {
 	return &OrchestratorEnvironmentResource{}
@@ -43,6 +45,18 @@ func (self *OrchestratorEnvironmentResource) Schema(
 	resp.Schema = OrchestratorEnvironmentSchema()
 }
 
+func (self *OrchestratorEnvironmentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(OrchestratorEnvironmentSchema().Version)
+}
+
+func (self *OrchestratorEnvironmentResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *OrchestratorEnvironmentResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -84,6 +98,7 @@ func (self *OrchestratorEnvironmentResource) Create(
 	// Optionally wait up-to-date then save updated environment into Terraform state
 	resp.Diagnostics.Append(self.WaitUpToDate(ctx, &environment)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &environment)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", environment.String()))
 }
 
@@ -114,6 +129,7 @@ func (self *OrchestratorEnvironmentResource) Read(
 	// Save updated environment into Terraform state
 	resp.Diagnostics.Append(environment.FromAPI(ctx, environmentFromAPI, response)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &environment)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *OrchestratorEnvironmentResource) Update(
@@ -165,6 +181,7 @@ func (self *OrchestratorEnvironmentResource) Update(
 	// Optionally wait up-to-date then save updated environment into Terraform state
 	resp.Diagnostics.Append(self.WaitUpToDate(ctx, &environment)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &environment)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", environment.String()))
 }
 
@@ -186,7 +203,7 @@ func (self *OrchestratorEnvironmentResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_up_to_date"), true)...)
 }
 
@@ -0,0 +1,29 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewOrchestratorWorkflowListResource() list.ListResource {
+	return &GenericListResource{config: OrchestratorWorkflowListResourceConfig()}
+}
+
+func OrchestratorWorkflowListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_orchestrator_workflow",
+		NewResource: NewOrchestratorWorkflowResource,
+		ListPath:    "vco/api/workflows",
+		Format:      LIST_FORMAT_VRO,
+		Filters: []ListFilter{
+			{
+				Attribute:   "category_id",
+				Description: "List only the workflows of this category",
+				Field:       "categoryId",
+			},
+		},
+		ImportKeyFields:    []string{"categoryPath", "name"},
+		ImportKeySeparator: "/",
+		CategoryType:       "WorkflowCategory",
+	}
+}
@@ -182,6 +182,10 @@ func (self OrchestratorWorkflowModel) DeletePath() string {
 	return "vco/api/workflows/" + self.Id.ValueString()
 }
 
+func (self OrchestratorWorkflowModel) IsForceDelete() bool {
+	return self.ForceDelete.ValueBool()
+}
+
 // Save response from create API endpoint.
 func (self *OrchestratorWorkflowModel) FromCreateAPI(raw OrchestratorWorkflowCreateAPIModel) {
 	self.Id = types.StringValue(raw.Id)
@@ -194,6 +198,16 @@ func (self *OrchestratorWorkflowModel) FromContentAPI(
 	ctx context.Context,
 	raw OrchestratorWorkflowContentAPIModel,
 	response *resty.Response,
+) diag.Diagnostics {
+	diags := self.FromContent(ctx, raw)
+	self.VersionId = types.StringValue(response.Header().Get("x-vro-changeset-sha"))
+	return diags
+}
+
+// Save content (e.g. converted from a workflow XML export).
+func (self *OrchestratorWorkflowModel) FromContent(
+	ctx context.Context,
+	raw OrchestratorWorkflowContentAPIModel,
 ) diag.Diagnostics {
 	self.Id = types.StringValue(raw.Id)
 	self.Name = types.StringValue(raw.Name)
@@ -203,7 +217,6 @@ func (self *OrchestratorWorkflowModel) FromContentAPI(
 	// FIXME How to retrieve CategoryId ? Yet another API endpoint to call?
 
 	self.Version = types.StringValue(raw.Version)
-	self.VersionId = types.StringValue(response.Header().Get("x-vro-changeset-sha"))
 	self.AllowedOperations = types.StringValue(raw.AllowedOperations)
 	self.ObjectName = types.StringValue(raw.ObjectName)
 	self.RestartMode = types.Int32Value(raw.RestartMode)
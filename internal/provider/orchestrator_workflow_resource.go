@@ -16,7 +16,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorWorkflowResource{}
+var _ resource.ResourceWithIdentity = &OrchestratorWorkflowResource{}
 var _ resource.ResourceWithImportState = &OrchestratorWorkflowResource{}
+var _ resource.ResourceWithUpgradeState = &OrchestratorWorkflowResource{}
 
 func NewOrchestratorWorkflowResource() resource.Resource {
 	return &OrchestratorWorkflowResource{}
@@ -43,6 +45,18 @@ func (self *OrchestratorWorkflowResource) Schema(
 	resp.Schema = OrchestratorWorkflowSchema()
 }
 
+func (self *OrchestratorWorkflowResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(OrchestratorWorkflowSchema().Version)
+}
+
+func (self *OrchestratorWorkflowResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *OrchestratorWorkflowResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -138,6 +152,7 @@ func (self *OrchestratorWorkflowResource) Create(
 	// Optionally wait imported then save updated workflow into Terraform state
 	resp.Diagnostics.Append(self.WaitImported(ctx, &workflow)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &workflow)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated (post-import) %s successfully", workflow.String()))
 }
 
@@ -181,6 +196,7 @@ func (self *OrchestratorWorkflowResource) Read(
 	resp.Diagnostics.Append(workflow.FromFormAPI(ctx, formsFromAPI)...)
 	workflow.FromVersionsAPI(versionsFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &workflow)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *OrchestratorWorkflowResource) Update(
@@ -223,6 +239,7 @@ func (self *OrchestratorWorkflowResource) Update(
 	// Optionally wait imported then save updated workflow into Terraform state
 	resp.Diagnostics.Append(self.WaitImported(ctx, &workflow)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &workflow)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated (post-import) %s successfully", workflow.String()))
 }
 
@@ -244,7 +261,7 @@ func (self *OrchestratorWorkflowResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrNaturalKey(ctx, self.client, OrchestratorWorkflowListResourceConfig(), req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_delete"), false)...)
 }
 
@@ -0,0 +1,247 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Elements of the workflow XML that are always converted to lists (even if there is only one).
+var workflowXMLListElements = []string{
+	"attrib", "bind", "param", "workflow-item", "property", "p-param", "p-group", "p-step",
+}
+
+// Elements of the workflow XML that are converted to (empty) strings when empty.
+var workflowXMLTextElements = []string{"display-name", "description", "desc"}
+
+// Attributes of the workflow XML that are converted to numbers or booleans.
+var workflowXMLFloatAttributes = []string{"x", "y"}
+var workflowXMLIntAttributes = []string{"comparator", "restartMode", "resumeFromFailedMode"}
+var workflowXMLBoolAttributes = []string{"encoded", "read-only"}
+
+// workflowXMLNode is a generic representation of an element of the workflow XML.
+type workflowXMLNode struct {
+	Name     string
+	Attrs    []xml.Attr
+	Children []*workflowXMLNode
+	Text     strings.Builder
+}
+
+// Convert a workflow XML export (workflow-content file) to the content API model.
+func OrchestratorWorkflowContentFromXML(content string) (OrchestratorWorkflowContentAPIModel, error) {
+	raw := OrchestratorWorkflowContentAPIModel{}
+
+	root, err := parseWorkflowXML(content)
+	if err != nil {
+		return raw, err
+	}
+	if root.Name != "workflow" {
+		return raw, fmt.Errorf("Root element must be a workflow, got %s", root.Name)
+	}
+
+	data, err := root.ToJSON("")
+	if err != nil {
+		return raw, err
+	}
+
+	// Content API model is the JSON representation of the XML
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return raw, fmt.Errorf("Unable to marshal workflow to JSON, got error: %s", err)
+	}
+	if err := json.Unmarshal(dataJSON, &raw); err != nil {
+		return raw, fmt.Errorf("Unable to unmarshal workflow content, got error: %s", err)
+	}
+	return raw, nil
+}
+
+func parseWorkflowXML(content string) (*workflowXMLNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	stack := []*workflowXMLNode{}
+	var root *workflowXMLNode
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Invalid XML, got error: %s", err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &workflowXMLNode{Name: token.Name.Local, Attrs: token.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text.Write(token)
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New("Invalid XML, no root element")
+	}
+	return root, nil
+}
+
+// Convert the node to its JSON representation (as returned by the content API).
+// The type of the parent (attribute) is required to convert values.
+func (self *workflowXMLNode) ToJSON(parentType string) (any, error) {
+	text := self.Text.String()
+	if len(strings.TrimSpace(text)) == 0 {
+		text = ""
+	}
+
+	if self.Name == "value" && len(parentType) > 0 && len(self.Children) == 0 {
+		return workflowXMLValueToJSON(parentType, self.Attrs, text)
+	}
+
+	if len(self.Attrs) == 0 && len(self.Children) == 0 {
+		if len(text) > 0 || slices.Contains(workflowXMLTextElements, self.Name) {
+			return text, nil
+		}
+		return map[string]any{}, nil
+	}
+
+	data := map[string]any{}
+	label := self.Name
+	nodeType := ""
+	for _, attr := range self.Attrs {
+		// Skip namespaces declarations and namespaced attributes (e.g. xsi:schemaLocation)
+		if attr.Name.Local == "xmlns" || len(attr.Name.Space) > 0 {
+			continue
+		}
+		value, err := workflowXMLAttrToJSON(attr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", label, err)
+		}
+		data[attr.Name.Local] = value
+		switch attr.Name.Local {
+		case "name":
+			label = fmt.Sprintf("%s %s", self.Name, attr.Value)
+		case "type":
+			nodeType = attr.Value
+		}
+	}
+	if len(text) > 0 {
+		data["value"] = text
+	}
+
+	// Only attributes and parameters have a value
+	if self.Name != "attrib" && self.Name != "param" {
+		nodeType = ""
+	}
+
+	for _, child := range self.Children {
+		value, err := child.ToJSON(nodeType)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", label, err)
+		}
+		if value == nil {
+			continue
+		}
+		existing, exists := data[child.Name]
+		switch {
+		case slices.Contains(workflowXMLListElements, child.Name) && !exists:
+			data[child.Name] = []any{value}
+		case !exists:
+			data[child.Name] = value
+		default:
+			if items, ok := existing.([]any); ok {
+				data[child.Name] = append(items, value)
+			} else {
+				data[child.Name] = []any{existing, value}
+			}
+		}
+	}
+	return data, nil
+}
+
+func workflowXMLAttrToJSON(attr xml.Attr) (any, error) {
+	name := attr.Name.Local
+	switch {
+	case slices.Contains(workflowXMLFloatAttributes, name):
+		value, err := strconv.ParseFloat(attr.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s %q, got error: %s", name, attr.Value, err)
+		}
+		return value, nil
+	case slices.Contains(workflowXMLIntAttributes, name):
+		value, err := strconv.ParseInt(attr.Value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s %q, got error: %s", name, attr.Value, err)
+		}
+		return value, nil
+	case slices.Contains(workflowXMLBoolAttributes, name):
+		value, err := strconv.ParseBool(attr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s %q, got error: %s", name, attr.Value, err)
+		}
+		return value, nil
+	}
+	return attr.Value, nil
+}
+
+// Convert a (serialized) value of the given type to its JSON representation.
+// Only scalars and SDK objects are supported, other types must be converted manually.
+func workflowXMLValueToJSON(valueType string, attrs []xml.Attr, text string) (any, error) {
+	for _, attr := range attrs {
+		if attr.Name.Local == "encoded" && attr.Value != "n" {
+			return nil, fmt.Errorf("Unsupported encoded value %q of type %s", attr.Value, valueType)
+		}
+	}
+
+	// No (default) value
+	if len(text) == 0 && valueType != "string" {
+		return nil, nil
+	}
+
+	switch valueType {
+	case "string":
+		return map[string]any{"string": map[string]any{"value": text}}, nil
+	case "number":
+		number, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid number %q, got error: %s", text, err)
+		}
+		return map[string]any{"number": map[string]any{"value": number}}, nil
+	case "boolean":
+		boolean, err := strconv.ParseBool(strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid boolean %q, got error: %s", text, err)
+		}
+		return map[string]any{"boolean": map[string]any{"value": boolean}}, nil
+	}
+
+	// SDK objects are serialized as dunes://service.dunes.ch/CustomSDKObject?id='...'&dunesName='...'
+	if strings.HasPrefix(text, "dunes://") {
+		uri, err := url.Parse(text)
+		if err == nil {
+			query := uri.Query()
+			id := strings.Trim(query.Get("id"), "'")
+			sdkType := strings.Trim(query.Get("dunesName"), "'")
+			if len(id) > 0 && len(sdkType) > 0 {
+				return map[string]any{"sdk-object": map[string]any{"id": id, "type": sdkType}}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Unsupported value of type %s, please remove it from the XML and set it manually", valueType)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testWorkflowXML = `<?xml version="1.0" encoding="UTF-8"?>
+<workflow xmlns="http://vmware.com/vco/workflow" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+    xsi:schemaLocation="http://vmware.com/vco/workflow http://vmware.com/vco/workflow/Workflow-v4.xsd"
+    root-name="item1" object-name="workflow:name=generic" id="wf-1" version="1.2.0"
+    api-version="6.0.0" allowed-operations="vfe" restartMode="1" resumeFromFailedMode="0">
+  <display-name><![CDATA[Sleep a while]]></display-name>
+  <description><![CDATA[Sleep.]]></description>
+  <position y="50.0" x="100.0"/>
+  <input>
+    <param name="host" type="VRA:Host">
+      <description><![CDATA[vRA host]]></description>
+    </param>
+  </input>
+  <output/>
+  <attrib name="sleepTime" type="number" read-only="false">
+    <value encoded="n"><![CDATA[20.0]]></value>
+  </attrib>
+  <attrib name="message" type="string" read-only="false">
+    <value encoded="n"><![CDATA[]]></value>
+  </attrib>
+  <attrib name="enabled" type="boolean" read-only="true">
+    <value encoded="n"><![CDATA[true]]></value>
+  </attrib>
+  <attrib name="host" type="VRA:Host" read-only="false">
+    <value encoded="n"><![CDATA[dunes://service.dunes.ch/CustomSDKObject?id='host-1'&dunesName='VRA:Host']]></value>
+  </attrib>
+  <attrib name="count" type="number" read-only="false"/>
+  <workflow-item name="item0" type="end" end-mode="0" comparator="0">
+    <in-binding/>
+    <position y="50.0" x="300.0"/>
+  </workflow-item>
+  <workflow-item name="item1" out-name="item0" type="task" comparator="0">
+    <display-name><![CDATA[Sleep]]></display-name>
+    <script encoded="false"><![CDATA[System.sleep(sleepTime * 1000);]]></script>
+    <in-binding>
+      <bind name="sleepTime" type="number" export-name="sleepTime"/>
+    </in-binding>
+    <out-binding/>
+    <position y="60.0" x="200.0"/>
+  </workflow-item>
+  <presentation/>
+</workflow>`
+
+func TestOrchestratorWorkflowContentFromXML(t *testing.T) {
+	raw, err := OrchestratorWorkflowContentFromXML(testWorkflowXML)
+	if err != nil {
+		t.Fatalf("OrchestratorWorkflowContentFromXML: %s", err)
+	}
+	CheckEqual(t, raw.Id, "wf-1")
+	CheckEqual(t, raw.Name, "Sleep a while")
+	CheckEqual(t, raw.Description, "Sleep.")
+	CheckEqual(t, raw.Version, "1.2.0")
+	CheckEqual(t, raw.AllowedOperations, "vfe")
+	CheckEqual(t, raw.ObjectName, "workflow:name=generic")
+	CheckEqual(t, raw.RootName, "item1")
+	CheckEqual(t, raw.RestartMode, int32(1))
+	CheckEqual(t, raw.ApiVersion, "6.0.0")
+	CheckEqual(t, raw.Position, PositionAPIModel{X: 100, Y: 50})
+	CheckDeepEqual(t, raw.Input.Param, []ParameterAPIModel{
+		{Name: "host", Description: "vRA host", Type: "VRA:Host"},
+	})
+	CheckEqual(t, len(raw.Output.Param), 0)
+
+	checkJSON := func(value any, expected string) {
+		t.Helper()
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("json.Marshal: %s", err)
+		}
+		CheckEqual(t, string(valueJSON), expected)
+	}
+	checkJSON(raw.Attrib, strings.Join([]string{
+		`[{"name":"sleepTime","read-only":false,"type":"number","value":{"number":{"value":20}}}`,
+		`{"name":"message","read-only":false,"type":"string","value":{"string":{"value":""}}}`,
+		`{"name":"enabled","read-only":true,"type":"boolean","value":{"boolean":{"value":true}}}`,
+		`{"name":"host","read-only":false,"type":"VRA:Host",` +
+			`"value":{"sdk-object":{"id":"host-1","type":"VRA:Host"}}}`,
+		`{"name":"count","read-only":false,"type":"number"}]`,
+	}, ","))
+	checkJSON(raw.WorkflowItem, strings.Join([]string{
+		`[{"comparator":0,"end-mode":"0","in-binding":{},"name":"item0",` +
+			`"position":{"x":300,"y":50},"type":"end"}`,
+		`{"comparator":0,"display-name":"Sleep",` +
+			`"in-binding":{"bind":[{"export-name":"sleepTime","name":"sleepTime","type":"number"}]},` +
+			`"name":"item1","out-binding":{},"out-name":"item0","position":{"x":200,"y":60},` +
+			`"script":{"encoded":false,"value":"System.sleep(sleepTime * 1000);"},"type":"task"}]`,
+	}, ","))
+	checkJSON(raw.Presentation, `{}`)
+}
+
+func TestOrchestratorWorkflowContentFromXMLErrors(t *testing.T) {
+	for _, test := range []struct {
+		content  string
+		expected string
+	}{
+		{`<workflow>`, "Invalid XML"},
+		{`<action name="a"/>`, "Root element must be a workflow, got action"},
+		{
+			`<workflow><attrib name="p" type="Properties"><value encoded="n">#{#}#</value></attrib></workflow>`,
+			"workflow: attrib p: Unsupported value of type Properties",
+		},
+		{
+			`<workflow><position x="a" y="1"/></workflow>`,
+			`workflow: position: Invalid x "a"`,
+		},
+	} {
+		_, err := OrchestratorWorkflowContentFromXML(test.content)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("OrchestratorWorkflowContentFromXML(%q): expected error %q, got %v",
+				test.content, test.expected, err)
+		}
+	}
+}
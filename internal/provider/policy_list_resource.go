@@ -0,0 +1,27 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewPolicyListResource() list.ListResource {
+	return &GenericListResource{config: PolicyListResourceConfig()}
+}
+
+func PolicyListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_policy",
+		NewResource: NewPolicyResource,
+		ListPath:    "policy/api/policies",
+		Format:      LIST_FORMAT_CONTENT,
+		Filters: []ListFilter{
+			{
+				Attribute:   "project_id",
+				Description: "List only the policies of this project",
+				Field:       "projectId",
+			},
+		},
+		ImportKeyFields: []string{"name"},
+	}
+}
@@ -8,10 +8,24 @@ import "github.com/hashicorp/terraform-plugin-framework/resource"
 func NewPolicyResource() resource.Resource {
 	return &GenericResource[PolicyModel, *PolicyModel, PolicyAPIModel]{
 		config: GenericResourceConfig{
-			TypeName:     "_policy",
-			SchemaFunc:   PolicySchema,
-			UpdateMethod: "POST",
-			UpdateCodes:  []int{201},
+			TypeName:           "_policy",
+			SchemaFunc:         PolicySchema,
+			ListResourceConfig: PolicyListResourceConfig,
+			UpdateMethod:       "POST",
+			UpdateCodes:        []int{201},
+			MoveStateSources: []MoveStateSource{
+				{
+					TypeName: "vra_policy",
+					Attributes: map[string]string{
+						"id":               "id",
+						"name":             "name",
+						"description":      "description",
+						"enforcement_type": "enforcement_type",
+						"type_id":          "type_id",
+						"project_id":       "project_id",
+					},
+				},
+			},
 		},
 	}
 }
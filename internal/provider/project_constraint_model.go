@@ -0,0 +1,80 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectConstraintModel describes the resource data model.
+type ProjectConstraintModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Mandatory  types.Bool   `tfsdk:"mandatory"`
+}
+
+// ProjectConstraintAPIModel describes the resource API model.
+type ProjectConstraintAPIModel struct {
+	Type        string                              `json:"type"`
+	Enforcement string                              `json:"enforcement"`
+	Occurrence  string                              `json:"occurrence"`
+	Expression  ProjectConstraintExpressionAPIModel `json:"expression"`
+}
+
+// ProjectConstraintExpressionAPIModel describes the resource API model.
+type ProjectConstraintExpressionAPIModel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (self ProjectConstraintModel) String() string {
+	return fmt.Sprintf("Project Constraint %s", self.Expression.ValueString())
+}
+
+func (self *ProjectConstraintModel) FromAPI(raw ProjectConstraintAPIModel) {
+	expression := raw.Expression.Key + ":" + raw.Expression.Value
+	if raw.Occurrence == "MUST_NOT_OCCUR" {
+		expression = "!" + expression
+	}
+	self.Expression = types.StringValue(expression)
+	self.Mandatory = types.BoolValue(raw.Enforcement == "HARD")
+}
+
+func (self ProjectConstraintModel) ToAPI() ProjectConstraintAPIModel {
+	// Expression is validated by the schema (e.g. "env:prod" or "!env:prod" to exclude)
+	expression := self.Expression.ValueString()
+	occurrence := "MUST_OCCUR"
+	if strings.HasPrefix(expression, "!") {
+		occurrence = "MUST_NOT_OCCUR"
+		expression = expression[1:]
+	}
+	key, value, _ := strings.Cut(expression, ":")
+
+	enforcement := "SOFT"
+	if self.Mandatory.ValueBool() {
+		enforcement = "HARD"
+	}
+
+	return ProjectConstraintAPIModel{
+		Type:        "TAG",
+		Enforcement: enforcement,
+		Occurrence:  occurrence,
+		Expression: ProjectConstraintExpressionAPIModel{
+			Key:   key,
+			Value: value,
+		},
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self ProjectConstraintModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"expression": types.StringType,
+		"mandatory":  types.BoolType,
+	}
+}
@@ -3,21 +3,117 @@
 
 package provider
 
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 // ProjectConstraintsModel describes the resource data model.
 type ProjectConstraintsModel struct {
+	// Of type ProjectConstraintModel
+	Network       types.List `tfsdk:"network"`
+	Storage       types.List `tfsdk:"storage"`
+	Extensibility types.List `tfsdk:"extensibility"`
 }
 
 // ProjectConstraintsAPIModel describes the resource API model.
 type ProjectConstraintsAPIModel struct {
+	Network       ProjectConstraintConditionsAPIModel `json:"network"`
+	Storage       ProjectConstraintConditionsAPIModel `json:"storage"`
+	Extensibility ProjectConstraintConditionsAPIModel `json:"extensibility"`
+}
+
+// ProjectConstraintConditionsAPIModel describes the resource API model.
+type ProjectConstraintConditionsAPIModel struct {
+	Conditions []ProjectConstraintAPIModel `json:"conditions"`
 }
 
 func (self ProjectConstraintsModel) String() string {
 	return "Project Constraints"
 }
 
-func (self *ProjectConstraintsModel) FromAPI(raw ProjectConstraintsAPIModel) {
+func (self *ProjectConstraintsModel) FromAPI(
+	ctx context.Context,
+	raw ProjectConstraintsAPIModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	var someDiags diag.Diagnostics
+
+	self.Network, someDiags = ProjectConstraintsFromAPI(ctx, raw.Network)
+	diags.Append(someDiags...)
+	self.Storage, someDiags = ProjectConstraintsFromAPI(ctx, raw.Storage)
+	diags.Append(someDiags...)
+	self.Extensibility, someDiags = ProjectConstraintsFromAPI(ctx, raw.Extensibility)
+	diags.Append(someDiags...)
+
+	return diags
+}
+
+func (self ProjectConstraintsModel) ToAPI(
+	ctx context.Context,
+) (ProjectConstraintsAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	networkRaw, someDiags := ProjectConstraintsToAPI(ctx, self.Network)
+	diags.Append(someDiags...)
+	storageRaw, someDiags := ProjectConstraintsToAPI(ctx, self.Storage)
+	diags.Append(someDiags...)
+	extensibilityRaw, someDiags := ProjectConstraintsToAPI(ctx, self.Extensibility)
+	diags.Append(someDiags...)
+
+	return ProjectConstraintsAPIModel{
+		Network:       networkRaw,
+		Storage:       storageRaw,
+		Extensibility: extensibilityRaw,
+	}, diags
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self ProjectConstraintsModel) AttributeTypes() map[string]attr.Type {
+	listType := types.ListType{
+		ElemType: types.ObjectType{AttrTypes: ProjectConstraintModel{}.AttributeTypes()},
+	}
+	return map[string]attr.Type{
+		"network":       listType,
+		"storage":       listType,
+		"extensibility": listType,
+	}
+}
+
+// Convert the constraints from raw to list.
+func ProjectConstraintsFromAPI(
+	ctx context.Context,
+	raw ProjectConstraintConditionsAPIModel,
+) (types.List, diag.Diagnostics) {
+	constraints := make([]ProjectConstraintModel, 0, len(raw.Conditions))
+	for _, constraintRaw := range raw.Conditions {
+		constraint := ProjectConstraintModel{}
+		constraint.FromAPI(constraintRaw)
+		constraints = append(constraints, constraint)
+	}
+	attrs := types.ObjectType{AttrTypes: ProjectConstraintModel{}.AttributeTypes()}
+	return types.ListValueFrom(ctx, attrs, constraints)
 }
 
-func (self ProjectConstraintsModel) ToAPI() ProjectConstraintsAPIModel {
-	return ProjectConstraintsAPIModel{}
+// Convert the constraints from list to raw.
+func ProjectConstraintsToAPI(
+	ctx context.Context,
+	list types.List,
+) (ProjectConstraintConditionsAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	constraintsRaw := []ProjectConstraintAPIModel{}
+	if list.IsNull() || list.IsUnknown() {
+		return ProjectConstraintConditionsAPIModel{Conditions: constraintsRaw}, diags
+	}
+
+	constraints := make([]ProjectConstraintModel, 0, len(list.Elements()))
+	diags.Append(list.ElementsAs(ctx, &constraints, false)...)
+	for _, constraint := range constraints {
+		constraintsRaw = append(constraintsRaw, constraint.ToAPI())
+	}
+	return ProjectConstraintConditionsAPIModel{Conditions: constraintsRaw}, diags
 }
@@ -0,0 +1,85 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectConstraintModelAPI(t *testing.T) {
+	cases := []struct {
+		expression  string
+		mandatory   bool
+		key         string
+		value       string
+		occurrence  string
+		enforcement string
+	}{
+		{"env:prod", true, "env", "prod", "MUST_OCCUR", "HARD"},
+		{"!env:dev", false, "env", "dev", "MUST_NOT_OCCUR", "SOFT"},
+		{"zone:", true, "zone", "", "MUST_OCCUR", "HARD"},
+		{"url:https://example.com", true, "url", "https://example.com", "MUST_OCCUR", "HARD"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expression, func(t *testing.T) {
+			constraint := ProjectConstraintModel{
+				Expression: types.StringValue(tc.expression),
+				Mandatory:  types.BoolValue(tc.mandatory),
+			}
+			raw := constraint.ToAPI()
+			CheckEqual(t, raw.Type, "TAG")
+			CheckEqual(t, raw.Expression.Key, tc.key)
+			CheckEqual(t, raw.Expression.Value, tc.value)
+			CheckEqual(t, raw.Occurrence, tc.occurrence)
+			CheckEqual(t, raw.Enforcement, tc.enforcement)
+
+			fromAPI := ProjectConstraintModel{}
+			fromAPI.FromAPI(raw)
+			CheckEqual(t, fromAPI, constraint)
+		})
+	}
+}
+
+func TestProjectConstraintExpressionRegex(t *testing.T) {
+	for expression, valid := range map[string]bool{
+		"env:prod":  true,
+		"!env:prod": true,
+		"env:":      true,
+		"env":       false,
+		":prod":     false,
+		"!:prod":    false,
+		"!!env:dev": false,
+		" env:prod": false,
+	} {
+		CheckEqual(t, PROJECT_CONSTRAINT_EXPRESSION_REGEX.MatchString(expression), valid)
+	}
+}
+
+func TestProjectConstraintsModelAPI(t *testing.T) {
+	ctx := t.Context()
+	constraints := ProjectConstraintsModel{}
+	diags := constraints.FromAPI(ctx, ProjectConstraintsAPIModel{
+		Network: ProjectConstraintConditionsAPIModel{
+			Conditions: []ProjectConstraintAPIModel{
+				{
+					Type:        "TAG",
+					Enforcement: "HARD",
+					Occurrence:  "MUST_OCCUR",
+					Expression:  ProjectConstraintExpressionAPIModel{Key: "net", Value: "dmz"},
+				},
+			},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, len(constraints.Network.Elements()), 1)
+	CheckEqual(t, len(constraints.Storage.Elements()), 0)
+
+	// Empty constraints are sent to clear them
+	raw, diags := constraints.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, len(raw.Network.Conditions), 1)
+	CheckEqual(t, raw.Storage.Conditions != nil && len(raw.Storage.Conditions) == 0, true)
+}
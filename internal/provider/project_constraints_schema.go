@@ -4,13 +4,70 @@
 package provider
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// A tag (key:value), optionally prefixed by ! to exclude it.
+var PROJECT_CONSTRAINT_EXPRESSION_REGEX = regexp.MustCompile(`^!?[^!:\s][^:]*:.*$`)
+
 func ProjectConstraintsSchema() schema.SingleNestedAttribute {
+	attrs := ProjectConstraintsModel{}.AttributeTypes()
+	empty := map[string]attr.Value{}
+	for name, listType := range attrs {
+		if listType, ok := listType.(types.ListType); ok {
+			empty[name] = types.ListValueMust(listType.ElemType, []attr.Value{})
+		}
+	}
 	return schema.SingleNestedAttribute{
-		MarkdownDescription: "Project constraints",
+		MarkdownDescription: "Project constraints, placement of the resources based on their tags",
+		Optional:            true,
+		Computed:            true,
+		Default:             objectdefault.StaticValue(types.ObjectValueMust(attrs, empty)),
+		Attributes: map[string]schema.Attribute{
+			"network":       ProjectConstraintListSchema("Network constraints"),
+			"storage":       ProjectConstraintListSchema("Storage constraints"),
+			"extensibility": ProjectConstraintListSchema("Extensibility constraints"),
+		},
+	}
+}
+
+func ProjectConstraintListSchema(description string) schema.ListNestedAttribute {
+	attrs := types.ObjectType{AttrTypes: ProjectConstraintModel{}.AttributeTypes()}
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
 		Optional:            true,
-		Attributes:          map[string]schema.Attribute{},
+		Computed:            true,
+		Default:             listdefault.StaticValue(types.ListValueMust(attrs, []attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"expression": schema.StringAttribute{
+					MarkdownDescription: "Tag expression `key:value` " +
+						"(prefixed by `!` to exclude the resources with this tag, e.g. `!env:dev`)",
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(
+							PROJECT_CONSTRAINT_EXPRESSION_REGEX,
+							"must be a tag key:value, optionally prefixed by ! (e.g. env:prod)",
+						),
+					},
+				},
+				"mandatory": schema.BoolAttribute{
+					MarkdownDescription: "Hard constraint if true (the default), " +
+						"soft constraint (preference) otherwise",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+		},
 	}
 }
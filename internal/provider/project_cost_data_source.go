@@ -0,0 +1,75 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectCostDataSource{}
+
+func NewProjectCostDataSource() datasource.DataSource {
+	return &ProjectCostDataSource{}
+}
+
+// ProjectCostDataSource defines the data source implementation.
+type ProjectCostDataSource struct {
+	client *AriaClient
+}
+
+func (self *ProjectCostDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_cost"
+}
+
+func (self *ProjectCostDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ProjectCostDataSourceSchema()
+}
+
+func (self *ProjectCostDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *ProjectCostDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var cost ProjectCostModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cost)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var costFromAPI ProjectCostAPIModel
+	path := cost.ReadPath()
+	response, err := self.client.R(path).SetResult(&costFromAPI).Get(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read %s, got error: %s", cost.String(), err))
+		return
+	}
+
+	// Save project cost into Terraform state
+	resp.Diagnostics.Append(cost.FromAPI(ctx, costFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cost)...)
+}
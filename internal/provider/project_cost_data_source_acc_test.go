@@ -0,0 +1,36 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectCostDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project to use for testing the data source."
+  type        = string
+}
+
+data "aria_project_cost" "cost" {
+  project_id = var.test_project_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_project_cost.cost", "project_id"),
+					resource.TestCheckResourceAttrSet("data.aria_project_cost.cost", "cost"),
+					resource.TestCheckResourceAttrSet("data.aria_project_cost.cost", "cost_unit"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectCostModel describes the data source data model.
+type ProjectCostModel struct {
+	ProjectId    types.String      `tfsdk:"project_id"`
+	Cost         types.Float64     `tfsdk:"cost"`
+	CostUnit     types.String      `tfsdk:"cost_unit"`
+	CostSyncTime timetypes.RFC3339 `tfsdk:"cost_sync_time"`
+	Message      types.String      `tfsdk:"message"`
+	Code         types.String      `tfsdk:"code"`
+
+	// Of type ProjectCostItemModel
+	Items types.List `tfsdk:"items"`
+}
+
+// ProjectCostAPIModel describes the data source API model.
+type ProjectCostAPIModel struct {
+	Cost         float64                   `json:"cost"`
+	CostUnit     string                    `json:"costUnit"`
+	CostSyncTime string                    `json:"costSyncTime"`
+	Message      string                    `json:"message"`
+	Code         string                    `json:"code"`
+	Items        []ProjectCostItemAPIModel `json:"costItems"`
+}
+
+// ProjectCostItemModel describes the data source data model.
+type ProjectCostItemModel struct {
+	Id   types.String  `tfsdk:"id"`
+	Name types.String  `tfsdk:"name"`
+	Type types.String  `tfsdk:"type"`
+	Cost types.Float64 `tfsdk:"cost"`
+}
+
+// ProjectCostItemAPIModel describes the data source API model.
+type ProjectCostItemAPIModel struct {
+	Id   string  `json:"id"`
+	Name string  `json:"name"`
+	Type string  `json:"type"`
+	Cost float64 `json:"cost"`
+}
+
+func (self ProjectCostModel) String() string {
+	return fmt.Sprintf("Project %s Cost", self.ProjectId.ValueString())
+}
+
+func (self ProjectCostModel) ReadPath() string {
+	return "project-service/api/projects/" + self.ProjectId.ValueString() + "/cost"
+}
+
+func (self *ProjectCostModel) FromAPI(
+	ctx context.Context,
+	raw ProjectCostAPIModel,
+) diag.Diagnostics {
+	self.Cost = types.Float64Value(raw.Cost)
+	self.CostUnit = types.StringValue(raw.CostUnit)
+	self.Message = types.StringValue(raw.Message)
+	self.Code = types.StringValue(raw.Code)
+
+	diags := diag.Diagnostics{}
+	var someDiags diag.Diagnostics
+
+	// Cost may have never been calculated
+	if len(raw.CostSyncTime) == 0 {
+		self.CostSyncTime = timetypes.NewRFC3339Null()
+	} else {
+		self.CostSyncTime, someDiags = timetypes.NewRFC3339Value(raw.CostSyncTime)
+		diags.Append(someDiags...)
+	}
+
+	items := make([]ProjectCostItemModel, 0, len(raw.Items))
+	for _, itemRaw := range raw.Items {
+		item := ProjectCostItemModel{}
+		item.FromAPI(itemRaw)
+		items = append(items, item)
+	}
+	attrs := types.ObjectType{AttrTypes: ProjectCostItemModel{}.AttributeTypes()}
+	self.Items, someDiags = types.ListValueFrom(ctx, attrs, items)
+	diags.Append(someDiags...)
+
+	return diags
+}
+
+func (self *ProjectCostItemModel) FromAPI(raw ProjectCostItemAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Type = types.StringValue(raw.Type)
+	self.Cost = types.Float64Value(raw.Cost)
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self ProjectCostItemModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+		"cost": types.Float64Type,
+	}
+}
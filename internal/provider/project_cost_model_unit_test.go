@@ -0,0 +1,37 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectCostModelFromAPI(t *testing.T) {
+	ctx := t.Context()
+	cost := ProjectCostModel{ProjectId: types.StringValue("project-1")}
+	CheckEqual(t, cost.ReadPath(), "project-service/api/projects/project-1/cost")
+
+	diags := cost.FromAPI(ctx, ProjectCostAPIModel{
+		Cost:         12.5,
+		CostUnit:     "CHF",
+		CostSyncTime: "2026-10-01T08:00:00Z",
+		Items: []ProjectCostItemAPIModel{
+			{Id: "vm-1", Name: "web-01", Type: "Cloud.vSphere.Machine", Cost: 10},
+			{Id: "disk-1", Name: "data-01", Type: "Cloud.vSphere.Disk", Cost: 2.5},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, cost.Cost.ValueFloat64(), 12.5)
+	CheckEqual(t, cost.CostUnit.ValueString(), "CHF")
+	CheckEqual(t, cost.CostSyncTime.ValueString(), "2026-10-01T08:00:00Z")
+	CheckEqual(t, len(cost.Items.Elements()), 2)
+
+	// Cost never calculated
+	diags = cost.FromAPI(ctx, ProjectCostAPIModel{})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, cost.CostSyncTime.IsNull(), true)
+	CheckEqual(t, len(cost.Items.Elements()), 0)
+}
@@ -3,23 +3,19 @@
 
 package provider
 
-/* https://github.com/davidfischer-ch/terraform-provider-aria/issues/52
-
 import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
 
-func ProjectCostSchema() schema.SingleNestedAttribute {
-	return schema.SingleNestedAttribute{
-		MarkdownDescription: "Project cost",
+// https://github.com/davidfischer-ch/terraform-provider-aria/issues/52
+// Read-only data source instead of an attribute of the project (prevent drift).
+func ProjectCostDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Project cost data source",
 		Attributes: map[string]schema.Attribute{
-			"cost": schema.NumberAttribute{
+			"project_id": RequiredProjectIdSchema(),
+			"cost": schema.Float64Attribute{
 				MarkdownDescription: "Cost of project",
 				Computed:            true,
 			},
@@ -28,18 +24,42 @@ func ProjectCostSchema() schema.SingleNestedAttribute {
 				Computed:            true,
 			},
 			"cost_sync_time": schema.StringAttribute{
-				CustomType: timetypes.RFC3339Type,
-				MarkdownDescription: "The date as of which project cost was calculated.",
-				Computed: true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The date as of which project cost was calculated",
+				Computed:            true,
 			},
 			"message": schema.StringAttribute{
 				MarkdownDescription: "Message regarding the project cost",
-				Computed: true,
+				Computed:            true,
 			},
 			"code": schema.StringAttribute{
 				MarkdownDescription: "Unique code for the message",
-				Computed: true,
+				Computed:            true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "Cost breakdown per resource",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Resource identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Resource name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Resource type",
+							Computed:            true,
+						},
+						"cost": schema.Float64Attribute{
+							MarkdownDescription: "Cost of the resource",
+							Computed:            true,
+						},
+					},
+				},
 			},
 		},
 	}
-} */
+}
@@ -0,0 +1,141 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client *AriaClient
+}
+
+func (self *ProjectDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (self *ProjectDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ProjectDataSourceSchema()
+}
+
+func (self *ProjectDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self ProjectDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *ProjectDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var project ProjectModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the identifier of the project matching given name
+	if len(project.Id.ValueString()) == 0 {
+		projectId, err := self.LookupId(project)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to find %s, got error: %s", project.String(), err))
+			return
+		}
+		project.Id = types.StringValue(projectId)
+	}
+
+	// Retrieve details from the project's API endpoint
+	var projectFromAPI ProjectAPIModel
+	path := project.ReadPath()
+	response, err := self.client.R(path).SetResult(&projectFromAPI).Get(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read %s, got error: %s", project.String(), err))
+		return
+	}
+
+	// Save project into Terraform state
+	resp.Diagnostics.Append(project.FromAPI(ctx, projectFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &project)...)
+}
+
+// Return the identifier of the project matching exactly the name.
+// An error is returned if there is no such project or if the name is ambiguous.
+func (self *ProjectDataSource) LookupId(project ProjectModel) (string, error) {
+	name := project.Name.ValueString()
+	var listFromAPI ProjectListAPIModel
+	listPath := project.ListPath()
+	response, err := self.client.R(listPath).
+		SetQueryParam("$filter", fmt.Sprintf("name eq %s", ODataString(name))).
+		SetQueryParam("size", "1000"). // Don't want to play with pagination
+		SetResult(&listFromAPI).
+		Get(listPath)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return "", err
+	}
+
+	// The filter may not be exact (e.g. case insensitive), ensure names are matching
+	projectIds := []string{}
+	for _, projectRaw := range listFromAPI.Content {
+		if projectRaw.Name == name {
+			projectIds = append(projectIds, projectRaw.Id)
+		}
+	}
+
+	switch len(projectIds) {
+	case 0:
+		return "", errors.New("no project matching name")
+	case 1:
+		return projectIds[0], nil
+	default:
+		return "", fmt.Errorf(
+			"project name is ambiguous, %d projects are matching (%s), use id instead",
+			len(projectIds), strings.Join(projectIds, ", "))
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project to use for testing the data source."
+  type        = string
+}
+
+data "aria_project" "by_id" {
+  id = var.test_project_id
+}
+
+data "aria_project" "by_name" {
+  name = data.aria_project.by_id.name
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_project.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.aria_project.by_id", "org_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_project.by_name", "id",
+						"data.aria_project.by_id", "id",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ProjectDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Project data source, lookup by identifier or (exact) name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier (either id or name must be set)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Project name, must match exactly one project " +
+					"(either id or name must be set)",
+				Computed: true,
+				Optional: true,
+			},
+			"operation_timeout": schema.Int32Attribute{
+				MarkdownDescription: "Timeout (in seconds) that should be used for " +
+					"Cloud Template operations and Provisioning tasks",
+				Computed: true,
+			},
+			"shared_resources": schema.BoolAttribute{
+				MarkdownDescription: "Specifies whetever the resources are shared between " +
+					"project's members or not",
+				Computed: true,
+			},
+			"administrators":   ProjectPrincipalsDataSourceSchema("Administrators"),
+			"members":          ProjectPrincipalsDataSourceSchema("Members"),
+			"viewers":          ProjectPrincipalsDataSourceSchema("Viewers"),
+			"supervisors":      ProjectPrincipalsDataSourceSchema("Supervisors"),
+			"zone_assignments": ProjectZoneAssignmentsDataSourceSchema(),
+			"constraints":      ProjectConstraintsDataSourceSchema(),
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "Custom properties to attach to project's resources",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func ProjectPrincipalsDataSourceSchema(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description + " (users or groups) of the project",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"email": schema.StringAttribute{
+					MarkdownDescription: "The username of the user or display name of the group",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Principal type, either `user` or `group`",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func ProjectZoneAssignmentsDataSourceSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Cloud zones where the project's resources can be provisioned",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"zone_id": schema.StringAttribute{
+					MarkdownDescription: "Cloud zone identifier",
+					Computed:            true,
+				},
+				"priority": schema.Int32Attribute{
+					MarkdownDescription: "Priority of the cloud zone, " +
+						"the lower the value, the higher the priority",
+					Computed: true,
+				},
+				"max_instances": schema.Int32Attribute{
+					MarkdownDescription: "Maximum number of instances that can be provisioned " +
+						"in the cloud zone (0 means unlimited)",
+					Computed: true,
+				},
+				"cpu_limit": schema.Int32Attribute{
+					MarkdownDescription: "Maximum number of CPUs that can be allocated " +
+						"in the cloud zone (0 means unlimited)",
+					Computed: true,
+				},
+				"memory_limit_mb": schema.Int32Attribute{
+					MarkdownDescription: "Maximum amount of memory (MB) that can be allocated " +
+						"in the cloud zone (0 means unlimited)",
+					Computed: true,
+				},
+				"storage_limit_gb": schema.Int32Attribute{
+					MarkdownDescription: "Maximum amount of storage (GB) that can be allocated " +
+						"in the cloud zone (0 means unlimited)",
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ProjectConstraintsDataSourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Project constraints, placement of the resources based on their tags",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"network":       ProjectConstraintListDataSourceSchema("Network constraints"),
+			"storage":       ProjectConstraintListDataSourceSchema("Storage constraints"),
+			"extensibility": ProjectConstraintListDataSourceSchema("Extensibility constraints"),
+		},
+	}
+}
+
+func ProjectConstraintListDataSourceSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"expression": schema.StringAttribute{
+					MarkdownDescription: "Tag expression `key:value` " +
+						"(prefixed by `!` to exclude the resources with this tag)",
+					Computed: true,
+				},
+				"mandatory": schema.BoolAttribute{
+					MarkdownDescription: "Hard constraint if true, " +
+						"soft constraint (preference) otherwise",
+					Computed: true,
+				},
+			},
+		},
+	}
+}
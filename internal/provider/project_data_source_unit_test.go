@@ -0,0 +1,107 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Return a fake projects API (list filtered by name and read).
+func newFakeProjectsAPI(t *testing.T) *httptest.Server {
+	projects := []ProjectAPIModel{
+		{Id: "project-1", Name: "Unique"},
+		{Id: "project-2", Name: "Twin"},
+		{Id: "project-3", Name: "Twin"},
+		{Id: "project-4", Name: "unique"},
+		{Id: "project-5", Name: "Rock'n'Roll"},
+	}
+	return newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project-service/api/projects" {
+			for _, project := range projects {
+				if r.URL.Path == "/project-service/api/projects/"+project.Id {
+					writeJSONStatus(w, http.StatusOK, project)
+					return
+				}
+			}
+			writeJSONStatus(w, http.StatusNotFound, map[string]string{})
+			return
+		}
+		// Mimic a case insensitive filter
+		filter := r.URL.Query().Get("$filter")
+		name := strings.TrimSuffix(strings.TrimPrefix(filter, "name eq '"), "'")
+		name = strings.ReplaceAll(name, "''", "'")
+		content := []ProjectAPIModel{}
+		for _, project := range projects {
+			if strings.EqualFold(project.Name, name) {
+				content = append(content, project)
+			}
+		}
+		writeJSONStatus(w, http.StatusOK, ProjectListAPIModel{Content: content})
+	})
+}
+
+func TestProjectDataSourceLookupId(t *testing.T) {
+	server := newFakeProjectsAPI(t)
+	dataSource := ProjectDataSource{client: newTestClient(t, server.URL)}
+
+	projectId, err := dataSource.LookupId(ProjectModel{Name: types.StringValue("Unique")})
+	CheckEqual(t, err, nil)
+	CheckEqual(t, projectId, "project-1")
+
+	_, err = dataSource.LookupId(ProjectModel{Name: types.StringValue("Twin")})
+	CheckEqual(t, err != nil && strings.Contains(err.Error(), "ambiguous"), true)
+	CheckEqual(t, strings.Contains(err.Error(), "project-2, project-3"), true)
+
+	projectId, err = dataSource.LookupId(ProjectModel{Name: types.StringValue("Rock'n'Roll")})
+	CheckEqual(t, err, nil)
+	CheckEqual(t, projectId, "project-5")
+
+	_, err = dataSource.LookupId(ProjectModel{Name: types.StringValue("Missing")})
+	CheckEqual(t, err != nil && strings.Contains(err.Error(), "no project"), true)
+}
+
+func TestProjectDataSourceRead(t *testing.T) {
+	ctx := t.Context()
+	server := newFakeProjectsAPI(t)
+	dataSource := ProjectDataSource{client: newTestClient(t, server.URL)}
+
+	// Realistic configuration, only the name is set (others are null)
+	schemaResp := datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "Unique")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, values),
+			Schema: schemaResp.Schema,
+		},
+	}
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(objectType, nil),
+			Schema: schemaResp.Schema,
+		},
+	}
+	dataSource.Read(ctx, req, &resp)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+
+	var project ProjectModel
+	CheckDiagnostics(t, resp.State.Get(ctx, &project), "", "")
+	CheckEqual(t, project.Id.ValueString(), "project-1")
+	CheckEqual(t, project.Name.ValueString(), "Unique")
+	CheckEqual(t, project.Constraints != nil, true)
+}
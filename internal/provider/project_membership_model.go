@@ -5,42 +5,127 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ProjectMembershipModel describes the resource data model.
 type ProjectMembershipModel struct {
-	Email types.String `tfsdk:"email"`
-	Type  types.String `tfsdk:"type"`
-	Role  types.String `tfsdk:"role"`
+	Id        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Email     types.String `tfsdk:"email"`
+	Type      types.String `tfsdk:"type"`
+	Role      types.String `tfsdk:"role"`
 }
 
 // ProjectMembershipAPIModel describes the resource API model.
 type ProjectMembershipAPIModel struct {
 	Email string `json:"email"`
 	Type  string `json:"type"`
-	Role  string `json:"role"`
+	Role  string `json:"role,omitempty"`
+}
+
+// ProjectMembershipsAPIModel describes the changes of the principals of a project.
+type ProjectMembershipsAPIModel struct {
+	Modify []ProjectMembershipAPIModel `json:"modify"`
+	Remove []ProjectMembershipAPIModel `json:"remove"`
 }
 
 func (self ProjectMembershipModel) String() string {
 	return fmt.Sprintf(
-		"Project %s Membership %s %s",
+		"Project %s %s Membership %s %s",
+		self.ProjectId.ValueString(),
 		self.Role.ValueString(),
 		self.Type.ValueString(),
 		self.Email.ValueString())
 }
 
-func (self *ProjectMembershipModel) FromAPI(raw ProjectMembershipAPIModel) {
-	self.Email = types.StringValue(raw.Email)
-	self.Type = types.StringValue(raw.Type)
-	self.Role = types.StringValue(raw.Role)
+// Return an appropriate key that can be used for naming mutexes.
+// Create Read Update Delete: Identifier can be used to prevent concurrent modifications on the
+// principals of the project.
+func (self ProjectMembershipModel) LockKey() string {
+	return "project-" + self.ProjectId.ValueString() // Its not a mistake, shared.
+}
+
+func (self ProjectMembershipModel) CreatePath() string {
+	return self.UpdatePath()
+}
+
+func (self ProjectMembershipModel) ReadPath() string {
+	return "project-service/api/projects/" + self.ProjectId.ValueString()
+}
+
+func (self ProjectMembershipModel) UpdatePath() string {
+	return self.ReadPath() + "/principals"
+}
+
+func (self ProjectMembershipModel) DeletePath() string {
+	return self.UpdatePath()
+}
+
+// Set the identifier from the project, the type and the email.
+func (self *ProjectMembershipModel) GenerateId() {
+	self.Id = types.StringValue(strings.Join([]string{
+		self.ProjectId.ValueString(),
+		self.Type.ValueString(),
+		self.Email.ValueString(),
+	}, ":"))
+}
+
+// Set the project, the type and the email from the identifier.
+func (self *ProjectMembershipModel) ParseId() error {
+	parts := strings.SplitN(self.Id.ValueString(), ":", 3)
+	if len(parts) != 3 || len(parts[0]) == 0 || len(parts[1]) == 0 || len(parts[2]) == 0 {
+		return fmt.Errorf(
+			"expected identifier with format <project_id>:<type>:<email>, got %q",
+			self.Id.ValueString())
+	}
+	self.ProjectId = types.StringValue(parts[0])
+	self.Type = types.StringValue(parts[1])
+	self.Email = types.StringValue(parts[2])
+	return nil
+}
+
+// Set the role from the principals of the project (returns false if the principal is not found).
+func (self *ProjectMembershipModel) FromAPI(raw ProjectAPIModel) bool {
+	principalsByRole := raw.PrincipalsByRole()
+	for _, role := range PROJECT_MEMBERSHIP_ROLES {
+		for _, principal := range principalsByRole[role] {
+			if principal.Type == self.Type.ValueString() &&
+				strings.EqualFold(principal.Email, self.Email.ValueString()) {
+				self.Role = types.StringValue(role)
+				self.GenerateId()
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Return the changes to grant (or update) this membership.
+func (self ProjectMembershipModel) ToAPI() ProjectMembershipsAPIModel {
+	return ProjectMembershipsAPIModel{
+		Modify: []ProjectMembershipAPIModel{
+			{
+				Email: self.Email.ValueString(),
+				Type:  self.Type.ValueString(),
+				Role:  self.Role.ValueString(),
+			},
+		},
+		Remove: []ProjectMembershipAPIModel{},
+	}
 }
 
-func (self ProjectMembershipModel) ToAPI() ProjectMembershipAPIModel {
-	return ProjectMembershipAPIModel{
-		Email: self.Email.ValueString(),
-		Type:  self.Type.ValueString(),
-		Role:  self.Role.ValueString(),
+// Return the changes to revoke this membership.
+func (self ProjectMembershipModel) ToDeleteAPI() ProjectMembershipsAPIModel {
+	return ProjectMembershipsAPIModel{
+		Modify: []ProjectMembershipAPIModel{},
+		Remove: []ProjectMembershipAPIModel{
+			{
+				Email: self.Email.ValueString(),
+				Type:  self.Type.ValueString(),
+			},
+		},
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectMembershipModelId(t *testing.T) {
+	membership := ProjectMembershipModel{
+		ProjectId: types.StringValue("project-1"),
+		Email:     types.StringValue("devops@example.com"),
+		Type:      types.StringValue("group"),
+	}
+	membership.GenerateId()
+	CheckEqual(t, membership.Id.ValueString(), "project-1:group:devops@example.com")
+	CheckEqual(t, membership.LockKey(), "project-project-1")
+	CheckEqual(t, membership.UpdatePath(), "project-service/api/projects/project-1/principals")
+
+	imported := ProjectMembershipModel{Id: membership.Id}
+	CheckEqual(t, imported.ParseId(), nil)
+	CheckEqual(t, imported.ProjectId.ValueString(), "project-1")
+	CheckEqual(t, imported.Type.ValueString(), "group")
+	CheckEqual(t, imported.Email.ValueString(), "devops@example.com")
+
+	imported = ProjectMembershipModel{Id: types.StringValue("project-1:devops@example.com")}
+	CheckEqual(t, imported.ParseId() != nil, true)
+}
+
+func TestProjectMembershipModelFromAPI(t *testing.T) {
+	project := ProjectAPIModel{
+		Administrators: []ProjectPrincipalAPIModel{{Email: "admin@example.com", Type: "user"}},
+		Viewers:        []ProjectPrincipalAPIModel{{Email: "DevOps@example.com", Type: "group"}},
+	}
+
+	membership := ProjectMembershipModel{
+		ProjectId: types.StringValue("project-1"),
+		Email:     types.StringValue("devops@example.com"),
+		Type:      types.StringValue("group"),
+	}
+	CheckEqual(t, membership.FromAPI(project), true)
+	CheckEqual(t, membership.Role.ValueString(), "viewer")
+	CheckEqual(t, membership.Id.ValueString(), "project-1:group:devops@example.com")
+
+	// Same email but another type of principal
+	membership.Type = types.StringValue("user")
+	CheckEqual(t, membership.FromAPI(project), false)
+}
+
+func TestProjectModelPrincipals(t *testing.T) {
+	ctx := t.Context()
+	project := ProjectModel{Properties: types.MapNull(types.StringType)}
+	diags := project.FromAPI(ctx, ProjectAPIModel{
+		Members: []ProjectPrincipalAPIModel{{Email: "dev@example.com", Type: "user"}},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, len(project.Members.Elements()), 1)
+	CheckEqual(t, len(project.Administrators.Elements()), 0)
+
+	// Unknown principals are kept as is (nil), known ones are sent even if empty
+	project.Viewers = types.SetUnknown(project.Viewers.ElementType(ctx))
+	raw, diags := project.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckDeepEqual(t, raw.Members, []ProjectPrincipalAPIModel{{Email: "dev@example.com", Type: "user"}})
+	CheckEqual(t, raw.Administrators != nil && len(raw.Administrators) == 0, true)
+	CheckEqual(t, raw.Viewers == nil, true)
+}
+
+func TestProjectModelForgetUnconfigured(t *testing.T) {
+	ctx := t.Context()
+	project := ProjectModel{Properties: types.MapNull(types.StringType)}
+	diags := project.FromAPI(ctx, ProjectAPIModel{
+		Administrators:  []ProjectPrincipalAPIModel{{Email: "admin@example.com", Type: "user"}},
+		Members:         []ProjectPrincipalAPIModel{{Email: "dev@example.com", Type: "user"}},
+		ZoneAssignments: []ProjectZoneAssignmentAPIModel{{ZoneId: "zone-1"}},
+	})
+	CheckDiagnostics(t, diags, "", "")
+
+	// Only the administrators are configured (members and zones are managed elsewhere)
+	projectSchema := ProjectSchema()
+	objectType := projectSchema.Type().TerraformType(ctx).(tftypes.Object)
+	principalType := objectType.AttributeTypes["administrators"].(tftypes.Set).ElementType
+	config := tfsdk.Config{
+		Raw: newProjectRawValue(t, map[string]tftypes.Value{
+			"administrators": tftypes.NewValue(objectType.AttributeTypes["administrators"],
+				[]tftypes.Value{
+					tftypes.NewValue(principalType, map[string]tftypes.Value{
+						"email": tftypes.NewValue(tftypes.String, "admin@example.com"),
+						"type":  tftypes.NewValue(tftypes.String, "user"),
+					}),
+				}),
+		}),
+		Schema: projectSchema,
+	}
+
+	CheckDiagnostics(t, project.ForgetUnconfigured(ctx, config), "", "")
+	raw, diags := project.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckDeepEqual(t, raw.Administrators, []ProjectPrincipalAPIModel{
+		{Email: "admin@example.com", Type: "user"},
+	})
+	CheckEqual(t, raw.Members == nil, true)
+	CheckEqual(t, raw.Viewers == nil, true)
+	CheckEqual(t, raw.Supervisors == nil, true)
+	CheckEqual(t, raw.ZoneAssignments == nil, true)
+}
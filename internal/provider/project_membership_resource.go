@@ -0,0 +1,211 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectMembershipResource{}
+var _ resource.ResourceWithIdentity = &ProjectMembershipResource{}
+var _ resource.ResourceWithImportState = &ProjectMembershipResource{}
+var _ resource.ResourceWithUpgradeState = &ProjectMembershipResource{}
+
+func NewProjectMembershipResource() resource.Resource {
+	return &ProjectMembershipResource{}
+}
+
+// ProjectMembershipResource defines the resource implementation.
+type ProjectMembershipResource struct {
+	client *AriaClient
+}
+
+func (self *ProjectMembershipResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_membership"
+}
+
+func (self *ProjectMembershipResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = ProjectMembershipSchema()
+}
+
+func (self *ProjectMembershipResource) UpgradeState(
+	ctx context.Context,
+) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(ProjectMembershipSchema().Version)
+}
+
+func (self *ProjectMembershipResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
+func (self *ProjectMembershipResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *ProjectMembershipResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var membership ProjectMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	self.client.Mutex.Lock(ctx, membership.LockKey())
+	defer self.client.Mutex.Unlock(ctx, membership.LockKey())
+
+	resp.Diagnostics.Append(self.PatchIt(membership, membership.ToAPI(), "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save membership into Terraform state
+	membership.GenerateId()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", membership.String()))
+}
+
+func (self *ProjectMembershipResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var membership ProjectMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported, retrieve the project, the type and the email from the identifier
+	if membership.ProjectId.IsNull() {
+		if err := membership.ParseId(); err != nil {
+			resp.Diagnostics.AddError("Invalid identifier", err.Error())
+			return
+		}
+	}
+
+	self.client.Mutex.RLock(ctx, membership.LockKey())
+	defer self.client.Mutex.RUnlock(ctx, membership.LockKey())
+
+	var projectFromAPI ProjectAPIModel
+	found, _, readDiags := self.client.ReadIt(&membership, &projectFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found || !membership.FromAPI(projectFromAPI) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated membership into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (self *ProjectMembershipResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var membership ProjectMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	self.client.Mutex.Lock(ctx, membership.LockKey())
+	defer self.client.Mutex.Unlock(ctx, membership.LockKey())
+
+	resp.Diagnostics.Append(self.PatchIt(membership, membership.ToAPI(), "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated membership into Terraform state
+	membership.GenerateId()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", membership.String()))
+}
+
+func (self *ProjectMembershipResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var membership ProjectMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	self.client.Mutex.Lock(ctx, membership.LockKey())
+	defer self.client.Mutex.Unlock(ctx, membership.LockKey())
+
+	resp.Diagnostics.Append(self.PatchIt(membership, membership.ToDeleteAPI(), "delete")...)
+}
+
+func (self *ProjectMembershipResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+}
+
+// -------------------------------------------------------------------------------------------------
+
+// Modify the principals of the project (grant, update or revoke the membership).
+func (self *ProjectMembershipResource) PatchIt(
+	membership ProjectMembershipModel,
+	body ProjectMembershipsAPIModel,
+	action string,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	path := membership.UpdatePath()
+	response, err := self.client.R(path).
+		SetQueryParam("validatePrincipals", "true").
+		SetQueryParam("syncPrincipals", "true").
+		SetBody(body).
+		Patch(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200, 204})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to %s %s, got error: %s", action, membership.String(), err))
+	}
+	return diags
+}
@@ -3,7 +3,7 @@
 
 package provider
 
-/* import (
+import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -11,49 +11,43 @@ package provider
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-// Named ProjectPrincipalsAssignment in Project's API Swagger
-func ProjectMembershipSchema() schema.NestedAttributeObject {
-	return schema.NestedAttributeObject{
-		MarkdownDescription: "A project membership granted to a user or group",
+var PROJECT_MEMBERSHIP_ROLES = []string{"administrator", "member", "supervisor", "viewer"}
+
+// Named ProjectPrincipalsAssignment in Project's API Swagger.
+func ProjectMembershipSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Project membership resource, grant access to a project to a user or " +
+			"group.\n\nDo not declare the same principals in the `administrators`, `members`, " +
+			"`supervisors` or `viewers` attributes of the project, they would conflict.",
 		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(
+				"Identifier (`<project_id>:<type>:<email>`)"),
+			"project_id": RequiredImmutableProjectIdSchema(),
 			"email": schema.StringAttribute{
-				MarkdownDescription: strings.Join(",", []string{
-					"example: administrator@vmware.com",
-					"The username of the user or display name of the group. "+
-					"When assigning a group, the email is expected to have the format "+
-					"displayName@domain. In the case where the display name in Identity provider "+
-					"is in the format:",
-					"* name@domain - email should be written as name@domain@domain",
-					"* name (and group has domain) - email should be written as name@domain",
-					"* name (and group doesn't have domain) - email should be written as name@",
-					"",
-					"to ensure proper functioning." + IMMUTABLE,
-				}),
+				MarkdownDescription: PROJECT_PRINCIPAL_EMAIL_DESCRIPTION + IMMUTABLE,
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Principal type, either user or group",
+				MarkdownDescription: "Principal type, either `user` or `group`" + IMMUTABLE,
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"user", "group"}...),
+					stringvalidator.OneOf(PROJECT_PRINCIPAL_TYPES...),
 				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "Access level, one of administrator, member, supervisor or "+
-					" viewer",
+				MarkdownDescription: "Access level, one of `administrator`, `member`, " +
+					"`supervisor` or `viewer`",
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"administrator",
-						"member",
-						"supervisor",
-						"viewer",
-					}...),
+					stringvalidator.OneOf(PROJECT_MEMBERSHIP_ROLES...),
 				},
 			},
 		},
 	}
-} */
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,9 +20,17 @@ type ProjectModel struct {
 	OperationTimeout types.Int32  `tfsdk:"operation_timeout"`
 	SharedResources  types.Bool   `tfsdk:"shared_resources"`
 
-	Constraints ProjectConstraintsModel `tfsdk:"constraints"`
-	Properties  types.Map               `tfsdk:"properties"`
-	/*Cost ProjectCostModel `tfsdk:"cost"`*/
+	// Of type ProjectPrincipalModel
+	Administrators types.Set `tfsdk:"administrators"`
+	Members        types.Set `tfsdk:"members"`
+	Viewers        types.Set `tfsdk:"viewers"`
+	Supervisors    types.Set `tfsdk:"supervisors"`
+
+	// Of type ProjectZoneAssignmentModel
+	ZoneAssignments types.Set `tfsdk:"zone_assignments"`
+
+	Constraints *ProjectConstraintsModel `tfsdk:"constraints"`
+	Properties  types.Map                `tfsdk:"properties"`
 
 	OrgId types.String `tfsdk:"org_id"`
 }
@@ -32,13 +42,27 @@ type ProjectAPIModel struct {
 	OperationTimeout int32  `json:"operationTimeout"`
 	SharedResources  bool   `json:"sharedResources"`
 
+	// Omitted if nil (principals are kept as is), sent if empty (all principals are removed)
+	Administrators []ProjectPrincipalAPIModel `json:"administrators,omitzero"`
+	Members        []ProjectPrincipalAPIModel `json:"members,omitzero"`
+	Viewers        []ProjectPrincipalAPIModel `json:"viewers,omitzero"`
+	Supervisors    []ProjectPrincipalAPIModel `json:"supervisors,omitzero"`
+
+	// Omitted if nil (zones are kept as is)
+	ZoneAssignments []ProjectZoneAssignmentAPIModel `json:"zones,omitzero"`
+
 	Constraints ProjectConstraintsAPIModel `json:"constraints"`
 	Properties  map[string]string          `json:"properties"`
-	/*Cost ProjectCostAPIModel `json:"cost"`*/
 
 	OrgId string `json:"orgId,omitempty"`
 }
 
+type ProjectListAPIModel struct {
+	Content          []ProjectAPIModel `json:"content"`
+	TotalElements    int               `json:"totalElements"`
+	NumberOfElements int               `json:"numberOfElements"`
+}
+
 func (self ProjectModel) String() string {
 	return fmt.Sprintf(
 		"Project %s (%s)",
@@ -57,6 +81,10 @@ func (self ProjectModel) CreatePath() string {
 	return "project-service/api/projects"
 }
 
+func (self ProjectModel) ListPath() string {
+	return "project-service/api/projects"
+}
+
 func (self ProjectModel) ReadPath() string {
 	return "project-service/api/projects/" + self.Id.ValueString()
 }
@@ -79,29 +107,101 @@ func (self *ProjectModel) FromAPI(
 	self.SharedResources = types.BoolValue(raw.SharedResources)
 	self.OrgId = types.StringValue(raw.OrgId)
 
-	self.Constraints.FromAPI(raw.Constraints)
+	self.Constraints = &ProjectConstraintsModel{}
+	diags := self.Constraints.FromAPI(ctx, raw.Constraints)
+	var someDiags diag.Diagnostics
 
-	var diags diag.Diagnostics
-	self.Properties, diags = types.MapValueFrom(ctx, types.StringType, raw.Properties)
+	self.Administrators, someDiags = ProjectPrincipalsFromAPI(ctx, raw.Administrators)
+	diags.Append(someDiags...)
+	self.Members, someDiags = ProjectPrincipalsFromAPI(ctx, raw.Members)
+	diags.Append(someDiags...)
+	self.Viewers, someDiags = ProjectPrincipalsFromAPI(ctx, raw.Viewers)
+	diags.Append(someDiags...)
+	self.Supervisors, someDiags = ProjectPrincipalsFromAPI(ctx, raw.Supervisors)
+	diags.Append(someDiags...)
+
+	self.ZoneAssignments, someDiags = ProjectZoneAssignmentsFromAPI(ctx, raw.ZoneAssignments)
+	diags.Append(someDiags...)
+
+	self.Properties, someDiags = types.MapValueFrom(ctx, types.StringType, raw.Properties)
+	diags.Append(someDiags...)
 
 	return diags
 }
 
+// Principals and zone assignments are kept as is if not set in the configuration (e.g. managed
+// by aria_project_membership resources), forget them to prevent overwriting the changes made
+// by other resources (the plan may hold their prior state).
+func (self *ProjectModel) ForgetUnconfigured(
+	ctx context.Context,
+	config tfsdk.Config,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for name, value := range map[string]*types.Set{
+		"administrators":   &self.Administrators,
+		"members":          &self.Members,
+		"viewers":          &self.Viewers,
+		"supervisors":      &self.Supervisors,
+		"zone_assignments": &self.ZoneAssignments,
+	} {
+		var configValue types.Set
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if configValue.IsNull() {
+			*value = types.SetNull(value.ElementType(ctx))
+		}
+	}
+	return diags
+}
+
 func (self ProjectModel) ToAPI(
 	ctx context.Context,
 ) (ProjectAPIModel, diag.Diagnostics) {
 
-	constraintsRaw := self.Constraints.ToAPI()
+	diags := diag.Diagnostics{}
+
+	administratorsRaw, someDiags := ProjectPrincipalsToAPI(ctx, self.Administrators)
+	diags.Append(someDiags...)
+	membersRaw, someDiags := ProjectPrincipalsToAPI(ctx, self.Members)
+	diags.Append(someDiags...)
+	viewersRaw, someDiags := ProjectPrincipalsToAPI(ctx, self.Viewers)
+	diags.Append(someDiags...)
+	supervisorsRaw, someDiags := ProjectPrincipalsToAPI(ctx, self.Supervisors)
+	diags.Append(someDiags...)
+	zoneAssignmentsRaw, someDiags := ProjectZoneAssignmentsToAPI(ctx, self.ZoneAssignments)
+	diags.Append(someDiags...)
+
+	// Null constraints means no constraints
+	constraints := ProjectConstraintsModel{}
+	if self.Constraints != nil {
+		constraints = *self.Constraints
+	}
+	constraintsRaw, someDiags := constraints.ToAPI(ctx)
+	diags.Append(someDiags...)
 	propertiesRaw := make(map[string]string, len(self.Properties.Elements()))
-	diags := self.Properties.ElementsAs(ctx, &propertiesRaw, false)
+	diags.Append(self.Properties.ElementsAs(ctx, &propertiesRaw, false)...)
 
 	return ProjectAPIModel{
 		Id:               self.Id.ValueString(),
 		Name:             self.Name.ValueString(),
 		OperationTimeout: self.OperationTimeout.ValueInt32(),
 		SharedResources:  self.SharedResources.ValueBool(),
+		Administrators:   administratorsRaw,
+		Members:          membersRaw,
+		Viewers:          viewersRaw,
+		Supervisors:      supervisorsRaw,
+		ZoneAssignments:  zoneAssignmentsRaw,
 		Constraints:      constraintsRaw,
 		Properties:       propertiesRaw,
 		OrgId:            self.OrgId.ValueString(),
 	}, diags
 }
+
+// Return the principals of the project by role.
+func (self ProjectAPIModel) PrincipalsByRole() map[string][]ProjectPrincipalAPIModel {
+	return map[string][]ProjectPrincipalAPIModel{
+		"administrator": self.Administrators,
+		"member":        self.Members,
+		"supervisor":    self.Supervisors,
+		"viewer":        self.Viewers,
+	}
+}
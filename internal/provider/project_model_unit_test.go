@@ -0,0 +1,56 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Return a project (resource) value with the given attributes, the others being null.
+func newProjectRawValue(t *testing.T, attributes map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	objectType := ProjectSchema().Type().TerraformType(t.Context()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestProjectModelImportedState(t *testing.T) {
+	ctx := t.Context()
+
+	// State being imported (or moved), only the identifier is known
+	state := tfsdk.State{
+		Raw: newProjectRawValue(t, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "project-1"),
+		}),
+		Schema: ProjectSchema(),
+	}
+	var project ProjectModel
+	CheckDiagnostics(t, state.Get(ctx, &project), "", "")
+	CheckEqual(t, project.Id.ValueString(), "project-1")
+	CheckEqual(t, project.Constraints == nil, true)
+
+	// Null constraints means no constraints
+	raw, diags := project.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckDeepEqual(t, raw.Constraints, ProjectConstraintsAPIModel{
+		Network:       ProjectConstraintConditionsAPIModel{Conditions: []ProjectConstraintAPIModel{}},
+		Storage:       ProjectConstraintConditionsAPIModel{Conditions: []ProjectConstraintAPIModel{}},
+		Extensibility: ProjectConstraintConditionsAPIModel{Conditions: []ProjectConstraintAPIModel{}},
+	})
+
+	// Refreshed state can be saved
+	CheckDiagnostics(t, project.FromAPI(ctx, ProjectAPIModel{Id: "project-1", Name: "Foo"}), "", "")
+	CheckEqual(t, project.Constraints != nil, true)
+	CheckDiagnostics(t, state.Set(ctx, &project), "", "")
+}
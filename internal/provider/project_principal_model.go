@@ -0,0 +1,87 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectPrincipalModel describes the resource data model.
+type ProjectPrincipalModel struct {
+	Email types.String `tfsdk:"email"`
+	Type  types.String `tfsdk:"type"`
+}
+
+// ProjectPrincipalAPIModel describes the resource API model.
+type ProjectPrincipalAPIModel struct {
+	Email string `json:"email"`
+	Type  string `json:"type"`
+}
+
+func (self ProjectPrincipalModel) String() string {
+	return fmt.Sprintf(
+		"Project Principal %s %s",
+		self.Type.ValueString(),
+		self.Email.ValueString())
+}
+
+func (self *ProjectPrincipalModel) FromAPI(raw ProjectPrincipalAPIModel) {
+	self.Email = types.StringValue(raw.Email)
+	self.Type = types.StringValue(raw.Type)
+}
+
+func (self ProjectPrincipalModel) ToAPI() ProjectPrincipalAPIModel {
+	return ProjectPrincipalAPIModel{
+		Email: self.Email.ValueString(),
+		Type:  self.Type.ValueString(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self ProjectPrincipalModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"email": types.StringType,
+		"type":  types.StringType,
+	}
+}
+
+// Convert the principals from raw to set.
+func ProjectPrincipalsFromAPI(
+	ctx context.Context,
+	raw []ProjectPrincipalAPIModel,
+) (types.Set, diag.Diagnostics) {
+	principals := make([]ProjectPrincipalModel, 0, len(raw))
+	for _, principalRaw := range raw {
+		principal := ProjectPrincipalModel{}
+		principal.FromAPI(principalRaw)
+		principals = append(principals, principal)
+	}
+	attrs := types.ObjectType{AttrTypes: ProjectPrincipalModel{}.AttributeTypes()}
+	return types.SetValueFrom(ctx, attrs, principals)
+}
+
+// Convert the principals from set to raw (nil if the set is null or unknown, to keep them as is).
+func ProjectPrincipalsToAPI(
+	ctx context.Context,
+	set types.Set,
+) ([]ProjectPrincipalAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+
+	principals := make([]ProjectPrincipalModel, 0, len(set.Elements()))
+	diags.Append(set.ElementsAs(ctx, &principals, false)...)
+	principalsRaw := make([]ProjectPrincipalAPIModel, 0, len(principals))
+	for _, principal := range principals {
+		principalsRaw = append(principalsRaw, principal.ToAPI())
+	}
+	return principalsRaw, diags
+}
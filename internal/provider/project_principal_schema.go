@@ -0,0 +1,52 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var PROJECT_PRINCIPAL_EMAIL_DESCRIPTION = strings.Join([]string{
+	"The username of the user or display name of the group (e.g. `administrator@vmware.com`).",
+	"",
+	"When assigning a group, the email is expected to have the format displayName@domain. " +
+		"In the case where the display name in Identity provider is in the format:",
+	"",
+	"* name@domain - email should be written as name@domain@domain",
+	"* name (and group has domain) - email should be written as name@domain",
+	"* name (and group doesn't have domain) - email should be written as name@",
+	"",
+	"to ensure proper functioning.",
+}, "\n")
+
+var PROJECT_PRINCIPAL_TYPES = []string{"user", "group"}
+
+// Named ProjectPrincipal in Project's API Swagger.
+func ProjectPrincipalsSchema(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description + " (users or groups) of the project, " +
+			"kept as is if not set (e.g. managed by `aria_project_membership` resources)",
+		Optional: true,
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"email": schema.StringAttribute{
+					MarkdownDescription: PROJECT_PRINCIPAL_EMAIL_DESCRIPTION,
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Principal type, either `user` or `group`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(PROJECT_PRINCIPAL_TYPES...),
+					},
+				},
+			},
+		},
+	}
+}
@@ -14,7 +14,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithIdentity = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithMoveState = &ProjectResource{}
+var _ resource.ResourceWithUpgradeState = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -41,6 +44,18 @@ func (self *ProjectResource) Schema(
 	resp.Schema = ProjectSchema()
 }
 
+func (self *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(ProjectSchema().Version)
+}
+
+func (self *ProjectResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *ProjectResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -57,6 +72,7 @@ func (self *ProjectResource) Create(
 	// Read Terraform plan data into the model
 	var project ProjectModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &project)...)
+	resp.Diagnostics.Append(project.ForgetUnconfigured(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -86,6 +102,7 @@ func (self *ProjectResource) Create(
 	// Save property group into Terraform state
 	resp.Diagnostics.Append(project.FromAPI(ctx, projectFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &project)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", project.String()))
 }
 
@@ -101,6 +118,9 @@ func (self *ProjectResource) Read(
 		return
 	}
 
+	self.client.Mutex.RLock(ctx, project.LockKey())
+	defer self.client.Mutex.RUnlock(ctx, project.LockKey())
+
 	var projectFromAPI ProjectAPIModel
 	found, _, readDiags := self.client.ReadIt(&project, &projectFromAPI)
 	resp.Diagnostics.Append(readDiags...)
@@ -116,6 +136,7 @@ func (self *ProjectResource) Read(
 	// Save updated property group into Terraform state
 	resp.Diagnostics.Append(project.FromAPI(ctx, projectFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &project)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *ProjectResource) Update(
@@ -126,10 +147,15 @@ func (self *ProjectResource) Update(
 	// Read Terraform plan data into the model
 	var project ProjectModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &project)...)
+	resp.Diagnostics.Append(project.ForgetUnconfigured(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Principals may be modified concurrently by aria_project_membership resources
+	self.client.Mutex.Lock(ctx, project.LockKey())
+	defer self.client.Mutex.Unlock(ctx, project.LockKey())
+
 	projectToAPI, diags := project.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -158,6 +184,7 @@ func (self *ProjectResource) Update(
 	// Save updated property group into Terraform state
 	resp.Diagnostics.Append(project.FromAPI(ctx, projectFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &project)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", project.String()))
 }
 
@@ -170,6 +197,8 @@ func (self *ProjectResource) Delete(
 	var project ProjectModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &project)...)
 	if !resp.Diagnostics.HasError() {
+		self.client.Mutex.Lock(ctx, project.LockKey())
+		defer self.client.Mutex.Unlock(ctx, project.LockKey())
 		resp.Diagnostics.Append(self.client.DeleteIt(&project)...)
 	}
 }
@@ -180,5 +209,30 @@ func (self *ProjectResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// FIXME must be filtered by id and projectId
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+}
+
+func (self *ProjectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return MoveStateFromSources(
+		MoveStateSource{
+			TypeName: "vra_project",
+			Attributes: map[string]string{
+				"id":                "id",
+				"name":              "name",
+				"operation_timeout": "operation_timeout",
+				"shared_resources":  "shared_resources",
+				"administrators":    "administrator_roles",
+				"members":           "member_roles",
+				"viewers":           "viewer_roles",
+				"supervisors":       "supervisor_roles",
+				"zone_assignments":  "zone_assignments",
+				"constraints":       "constraints",
+				"properties":        "custom_properties",
+			},
+			// Constraints block is stored as a list (of at most one element)
+			Converters: map[string]func(value any) any{
+				"constraints": FirstElement,
+			},
+		},
+	)
 }
@@ -14,7 +14,7 @@ import (
 
 func ProjectSchema() schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Project resource (WORK IN PROGRESS, DO NOT USE)",
+		MarkdownDescription: "Project resource",
 		Attributes: map[string]schema.Attribute{
 			"id": ComputedIdentifierSchema(""),
 			"name": schema.StringAttribute{
@@ -34,20 +34,18 @@ func ProjectSchema() schema.Schema {
 					"project's members or not",
 				Required: true,
 			},
-			/*"administrators": ProjectMembershipSchema("Administrators"),*/
-			/*"members": ProjectMembershipSchema("Members"),*/
-			/*"viewers": ProjectMembershipSchema("Viewers"),*/
-			/*"supervisors": ProjectMembershipSchema("Supervisors"),*/
-			"constraints": ProjectConstraintsSchema(),
+			"administrators":   ProjectPrincipalsSchema("Administrators"),
+			"members":          ProjectPrincipalsSchema("Members"),
+			"viewers":          ProjectPrincipalsSchema("Viewers"),
+			"supervisors":      ProjectPrincipalsSchema("Supervisors"),
+			"zone_assignments": ProjectZoneAssignmentsSchema(),
+			"constraints":      ProjectConstraintsSchema(),
 			"properties": schema.MapAttribute{
 				MarkdownDescription: "Custom properties to attach to project's resources",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
-			/*
-				https://github.com/davidfischer-ch/terraform-provider-aria/issues/52
-				"cost": ProjectCostSchema(),
-			*/
+			// Cost is exposed by the aria_project_cost data source (prevent drift)
 			"org_id": ComputedOrganizationIdSchema(),
 		},
 	}
@@ -0,0 +1,105 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectZoneAssignmentModel describes the resource data model.
+type ProjectZoneAssignmentModel struct {
+	ZoneId         types.String `tfsdk:"zone_id"`
+	Priority       types.Int32  `tfsdk:"priority"`
+	MaxInstances   types.Int32  `tfsdk:"max_instances"`
+	CPULimit       types.Int32  `tfsdk:"cpu_limit"`
+	MemoryLimitMB  types.Int32  `tfsdk:"memory_limit_mb"`
+	StorageLimitGB types.Int32  `tfsdk:"storage_limit_gb"`
+}
+
+// ProjectZoneAssignmentAPIModel describes the resource API model.
+type ProjectZoneAssignmentAPIModel struct {
+	ZoneId         string `json:"zoneId"`
+	Priority       int32  `json:"priority"`
+	MaxInstances   int32  `json:"maxNumberInstances"`
+	CPULimit       int32  `json:"cpuLimit"`
+	MemoryLimitMB  int32  `json:"memoryLimitMB"`
+	StorageLimitGB int32  `json:"storageLimitGB"`
+}
+
+func (self ProjectZoneAssignmentModel) String() string {
+	return fmt.Sprintf("Project Zone Assignment %s", self.ZoneId.ValueString())
+}
+
+func (self *ProjectZoneAssignmentModel) FromAPI(raw ProjectZoneAssignmentAPIModel) {
+	self.ZoneId = types.StringValue(raw.ZoneId)
+	self.Priority = types.Int32Value(raw.Priority)
+	self.MaxInstances = types.Int32Value(raw.MaxInstances)
+	self.CPULimit = types.Int32Value(raw.CPULimit)
+	self.MemoryLimitMB = types.Int32Value(raw.MemoryLimitMB)
+	self.StorageLimitGB = types.Int32Value(raw.StorageLimitGB)
+}
+
+func (self ProjectZoneAssignmentModel) ToAPI() ProjectZoneAssignmentAPIModel {
+	return ProjectZoneAssignmentAPIModel{
+		ZoneId:         self.ZoneId.ValueString(),
+		Priority:       self.Priority.ValueInt32(),
+		MaxInstances:   self.MaxInstances.ValueInt32(),
+		CPULimit:       self.CPULimit.ValueInt32(),
+		MemoryLimitMB:  self.MemoryLimitMB.ValueInt32(),
+		StorageLimitGB: self.StorageLimitGB.ValueInt32(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+func (self ProjectZoneAssignmentModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"zone_id":          types.StringType,
+		"priority":         types.Int32Type,
+		"max_instances":    types.Int32Type,
+		"cpu_limit":        types.Int32Type,
+		"memory_limit_mb":  types.Int32Type,
+		"storage_limit_gb": types.Int32Type,
+	}
+}
+
+// Convert the zone assignments from raw to set.
+func ProjectZoneAssignmentsFromAPI(
+	ctx context.Context,
+	raw []ProjectZoneAssignmentAPIModel,
+) (types.Set, diag.Diagnostics) {
+	assignments := make([]ProjectZoneAssignmentModel, 0, len(raw))
+	for _, assignmentRaw := range raw {
+		assignment := ProjectZoneAssignmentModel{}
+		assignment.FromAPI(assignmentRaw)
+		assignments = append(assignments, assignment)
+	}
+	attrs := types.ObjectType{AttrTypes: ProjectZoneAssignmentModel{}.AttributeTypes()}
+	return types.SetValueFrom(ctx, attrs, assignments)
+}
+
+// Convert the zone assignments from set to raw (nil if the set is null or unknown, to keep them
+// as is).
+func ProjectZoneAssignmentsToAPI(
+	ctx context.Context,
+	set types.Set,
+) ([]ProjectZoneAssignmentAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+
+	assignments := make([]ProjectZoneAssignmentModel, 0, len(set.Elements()))
+	diags.Append(set.ElementsAs(ctx, &assignments, false)...)
+	assignmentsRaw := make([]ProjectZoneAssignmentAPIModel, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignmentsRaw = append(assignmentsRaw, assignment.ToAPI())
+	}
+	return assignmentsRaw, diags
+}
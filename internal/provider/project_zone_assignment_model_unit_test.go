@@ -0,0 +1,31 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectZoneAssignmentsAPI(t *testing.T) {
+	ctx := t.Context()
+	raw := []ProjectZoneAssignmentAPIModel{
+		{ZoneId: "zone-1", Priority: 1, MaxInstances: 10, CPULimit: 40, MemoryLimitMB: 81920},
+		{ZoneId: "zone-2", Priority: 2, StorageLimitGB: 500},
+	}
+	assignments, diags := ProjectZoneAssignmentsFromAPI(ctx, raw)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, len(assignments.Elements()), 2)
+
+	rawAgain, diags := ProjectZoneAssignmentsToAPI(ctx, assignments)
+	CheckDiagnostics(t, diags, "", "")
+	CheckDeepEqual(t, rawAgain, raw)
+
+	// Unknown (not configured) zone assignments are kept as is
+	unknown := types.SetUnknown(assignments.ElementType(ctx))
+	rawAgain, diags = ProjectZoneAssignmentsToAPI(ctx, unknown)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, rawAgain == nil, true)
+}
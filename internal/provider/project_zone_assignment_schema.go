@@ -0,0 +1,56 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Named ZoneAssignment in Project's API Swagger.
+func ProjectZoneAssignmentsSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Cloud zones where the project's resources can be provisioned, " +
+			"kept as is if not set",
+		Optional: true,
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"zone_id": schema.StringAttribute{
+					MarkdownDescription: "Cloud zone identifier",
+					Required:            true,
+				},
+				"priority": ProjectZoneAssignmentLimitSchema(
+					"Priority of the cloud zone, the lower the value, the higher the priority " +
+						"(defaults to 0)"),
+				"max_instances": ProjectZoneAssignmentLimitSchema(
+					"Maximum number of instances that can be provisioned in the cloud zone " +
+						"(defaults to 0, unlimited)"),
+				"cpu_limit": ProjectZoneAssignmentLimitSchema(
+					"Maximum number of CPUs that can be allocated in the cloud zone " +
+						"(defaults to 0, unlimited)"),
+				"memory_limit_mb": ProjectZoneAssignmentLimitSchema(
+					"Maximum amount of memory (MB) that can be allocated in the cloud zone " +
+						"(defaults to 0, unlimited)"),
+				"storage_limit_gb": ProjectZoneAssignmentLimitSchema(
+					"Maximum amount of storage (GB) that can be allocated in the cloud zone " +
+						"(defaults to 0, unlimited)"),
+			},
+		},
+	}
+}
+
+func ProjectZoneAssignmentLimitSchema(description string) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Default:             int32default.StaticInt32(0),
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+		},
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewPropertyGroupListResource() list.ListResource {
+	return &GenericListResource{config: PropertyGroupListResourceConfig()}
+}
+
+func PropertyGroupListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_property_group",
+		NewResource: NewPropertyGroupResource,
+		ListPath:    "properties/api/property-groups",
+		Format:      LIST_FORMAT_CONTENT,
+		Filters: []ListFilter{
+			{
+				Attribute:   "project_id",
+				Description: "List only the property groups of this project",
+				Field:       "projectId",
+			},
+		},
+		ImportKeyFields: []string{"name"},
+	}
+}
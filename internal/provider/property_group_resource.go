@@ -8,8 +8,21 @@ import "github.com/hashicorp/terraform-plugin-framework/resource"
 func NewPropertyGroupResource() resource.Resource {
 	return &GenericResource[PropertyGroupModel, *PropertyGroupModel, PropertyGroupAPIModel]{
 		config: GenericResourceConfig{
-			TypeName:   "_property_group",
-			SchemaFunc: PropertyGroupSchema,
+			TypeName:           "_property_group",
+			SchemaFunc:         PropertyGroupSchema,
+			ListResourceConfig: PropertyGroupListResourceConfig,
+			MoveStateSources: []MoveStateSource{
+				{
+					TypeName: "vra_property_group",
+					Attributes: map[string]string{
+						"id":          "id",
+						"name":        "name",
+						"description": "description",
+						"type":        "type",
+						"project_id":  "project_id",
+					},
+				},
+			},
 		},
 	}
 }
@@ -35,13 +35,14 @@ type PropertyModel struct {
 
 // PropertyAPIModel describes the resource API model.
 type PropertyAPIModel struct {
-	Title            string `json:"title" yaml:"title"`
-	Description      string `json:"description" yaml:"description"`
+	// Default values are omitted from the content (YAML) of the cloud templates
+	Title            string `json:"title" yaml:"title,omitempty"`
+	Description      string `json:"description" yaml:"description,omitempty"`
 	Type             string `json:"type" yaml:"type"`
-	Default          any    `json:"default,omitempty" yaml:"default"`
-	Encrypted        bool   `json:"encrypted" yaml:"encrypted"`
-	ReadOnly         bool   `json:"readOnly" yaml:"readOnly"`
-	RecreateOnUpdate bool   `json:"recreateOnUpdate" yaml:"recreateOnUpdate"`
+	Default          any    `json:"default,omitempty" yaml:"default,omitempty"`
+	Encrypted        bool   `json:"encrypted" yaml:"encrypted,omitempty"`
+	ReadOnly         bool   `json:"readOnly" yaml:"readOnly,omitempty"`
+	RecreateOnUpdate bool   `json:"recreateOnUpdate" yaml:"recreateOnUpdate,omitempty"`
 
 	// Specifications
 	Minimum   *int64  `json:"minimum,omitempty" yaml:"minimum,omitempty"`
@@ -7,10 +7,13 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -22,7 +25,9 @@ import (
 
 // Ensure AriaProvider satisfies various provider interfaces.
 var _ provider.Provider = &AriaProvider{}
+var _ provider.ProviderWithEphemeralResources = &AriaProvider{}
 var _ provider.ProviderWithFunctions = &AriaProvider{}
+var _ provider.ProviderWithListResources = &AriaProvider{}
 
 // AriaProvider defines the provider implementation.
 type AriaProvider struct {
@@ -34,9 +39,11 @@ type AriaProvider struct {
 // AriaProviderModel describes the provider data model.
 type AriaProviderModel struct {
 	Host               types.String `tfsdk:"host"`
+	FallbackHosts      types.List   `tfsdk:"fallback_hosts"`
 	Insecure           types.Bool   `tfsdk:"insecure"`
 	RefreshToken       types.String `tfsdk:"refresh_token"`
 	AccessToken        types.String `tfsdk:"access_token"`
+	TokenCacheDir      types.String `tfsdk:"token_cache_dir"`
 	OKAPICallsLogLevel types.String `tfsdk:"ok_api_calls_log_level"`
 	KOAPICallsLogLevel types.String `tfsdk:"ko_api_calls_log_level"`
 }
@@ -62,6 +69,15 @@ func (self *AriaProvider) Schema(
 					"May also be provided via ARIA_HOST environment variable.",
 				Optional: true,
 			},
+			"fallback_hosts": schema.ListAttribute{
+				MarkdownDescription: "The URIs to the other nodes of Aria (tried in order). " +
+					"Requests fail over to the next node on connection errors or when host " +
+					"responds with HTTP 503, unavailable nodes are skipped for the remainder " +
+					"of the run. May also be provided via ARIA_FALLBACK_HOSTS environment " +
+					"variable (comma separated).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"refresh_token": schema.StringAttribute{
 				MarkdownDescription: "The refresh token to use for making API requests. " +
 					"May also be provided via ARIA_REFRESH_TOKEN environment variable.",
@@ -74,6 +90,13 @@ func (self *AriaProvider) Schema(
 				Optional:  true,
 				Sensitive: true,
 			},
+			"token_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory where access tokens retrieved with the refresh token " +
+					"are cached (readable only by current user) and reused across runs until " +
+					"shortly before they expire. Disabled by default. " +
+					"May also be provided via ARIA_TOKEN_CACHE_DIR environment variable.",
+				Optional: true,
+			},
 			"insecure": schema.BoolAttribute{
 				MarkdownDescription: "Whether server should be accessed without verifying the " +
 					"TLS certificate. May also be provided via ARIA_INSECURE environment variable.",
@@ -127,6 +150,15 @@ func (self *AriaProvider) Configure(
 		)
 	}
 
+	if config.FallbackHosts.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fallback_hosts"),
+			"Unknown Aria API Fallback Hosts",
+			"Either set the fallback hosts in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_FALLBACK_HOSTS.",
+		)
+	}
+
 	if config.RefreshToken.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("refresh_token"),
@@ -145,6 +177,15 @@ func (self *AriaProvider) Configure(
 		)
 	}
 
+	if config.TokenCacheDir.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_cache_dir"),
+			"Unknown Aria API Token Cache Directory",
+			"Either set the token cache directory in the provider configuration to a static "+
+				"value, apply the source of the value first, or use ARIA_TOKEN_CACHE_DIR.",
+		)
+	}
+
 	if config.Insecure.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("insecure"),
@@ -187,6 +228,12 @@ func (self *AriaProvider) Configure(
 		)
 	}
 
+	fallbackHosts := SkipEmpty(strings.Split(os.Getenv("ARIA_FALLBACK_HOSTS"), ","))
+	if !config.FallbackHosts.IsNull() {
+		fallbackHosts = []string{}
+		resp.Diagnostics.Append(config.FallbackHosts.ElementsAs(ctx, &fallbackHosts, false)...)
+	}
+
 	var insecure bool
 	if !config.Insecure.IsNull() {
 		insecure = config.Insecure.ValueBool()
@@ -225,6 +272,11 @@ func (self *AriaProvider) Configure(
 		)
 	}
 
+	tokenCacheDir := os.Getenv("ARIA_TOKEN_CACHE_DIR")
+	if !config.TokenCacheDir.IsNull() {
+		tokenCacheDir = config.TokenCacheDir.ValueString()
+	}
+
 	okLogLevel := os.Getenv("ARIA_OK_API_CALLS_LOG_LEVEL")
 	if !config.OKAPICallsLogLevel.IsNull() {
 		okLogLevel = config.OKAPICallsLogLevel.ValueString()
@@ -240,17 +292,21 @@ func (self *AriaProvider) Configure(
 	}
 
 	ctx = tflog.SetField(ctx, "aria_host", host)
+	ctx = tflog.SetField(ctx, "aria_fallback_hosts", fallbackHosts)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_refresh_token", refresh_token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_access_token", access_token)
 	ctx = tflog.SetField(ctx, "aria_insecure", insecure)
+	ctx = tflog.SetField(ctx, "aria_token_cache_dir", tokenCacheDir)
 
 	tflog.Debug(ctx, "Creating Aria client")
 
 	// Create a new Aria client using the configuration values
 	client := AriaClient{
 		Host:               host,
+		FallbackHosts:      fallbackHosts,
 		RefreshToken:       refresh_token,
 		AccessToken:        access_token,
+		TokenCacheDir:      tokenCacheDir,
 		Insecure:           insecure,
 		Context:            ctx,
 		OKAPICallsLogLevel: okLogLevel,
@@ -266,6 +322,8 @@ func (self *AriaProvider) Configure(
 	// Make the Aria client available for DataSource and Resource type Configure methods
 	resp.DataSourceData = &client
 	resp.ResourceData = &client
+	resp.EphemeralResourceData = &client
+	resp.ListResourceData = &client
 
 	tflog.Info(ctx, "Configured Aria client", map[string]any{"success": true})
 }
@@ -278,10 +336,14 @@ func (self *AriaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewCatalogItemIconResource,
 		NewCatalogSourceResource,
 		NewCloudTemplateV1Resource,
+		NewCloudZoneResource,
 		NewCustomFormResource,
 		NewCustomNamingResource,
 		NewCustomResourceResource,
+		NewFlavorProfileResource,
 		NewIconResource,
+		NewImageProfileResource,
+		NewNetworkProfileResource,
 		NewOrchestratorActionResource,
 		NewOrchestratorCategoryResource,
 		NewOrchestratorConfigurationResource,
@@ -291,8 +353,10 @@ func (self *AriaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewOrchestratorWorkflowResource,
 		NewPolicyResource,
 		NewProjectResource,
+		NewProjectMembershipResource,
 		NewPropertyGroupResource,
 		NewResourceActionResource,
+		NewStorageProfileResource,
 		NewSubscriptionResource,
 		NewTagResource,
 	}
@@ -302,15 +366,47 @@ func (self *AriaProvider) DataSources(ctx context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		NewCatalogItemDataSource,
 		NewCatalogTypeDataSource,
+		NewFabricNetworkDataSource,
 		NewIconDataSource,
 		NewIntegrationDataSource,
 		NewOrchestratorConfigurationDataSource,
+		NewProjectDataSource,
+		NewProjectCostDataSource,
 		NewSecretDataSource,
 	}
 }
 
+func (self *AriaProvider) EphemeralResources(
+	ctx context.Context,
+) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (self *AriaProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewABXActionListResource,
+		NewCatalogSourceListResource,
+		NewOrchestratorActionListResource,
+		NewOrchestratorCategoryListResource,
+		NewOrchestratorConfigurationListResource,
+		NewOrchestratorWorkflowListResource,
+		NewPolicyListResource,
+		NewPropertyGroupListResource,
+		NewSubscriptionListResource,
+	}
+}
+
 func (self *AriaProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCloudTemplateContentFunction,
+		NewIconHashFunction,
+		NewIconHashFileFunction,
+		NewRenderNamingPatternFunction,
+		NewSubscriptionCriteriaFunction,
+		NewWorkflowFromXMLFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,215 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RenderNamingPatternFunction{}
+
+func NewRenderNamingPatternFunction() function.Function {
+	return &RenderNamingPatternFunction{}
+}
+
+// RenderNamingPatternFunction defines the function implementation.
+type RenderNamingPatternFunction struct{}
+
+// NamingCounterModel describes the counter argument of the function.
+type NamingCounterModel struct {
+	StartCounter  types.Int64 `tfsdk:"start_counter"`
+	IncrementStep types.Int64 `tfsdk:"increment_step"`
+	Index         types.Int64 `tfsdk:"index"`
+}
+
+// Value of the counter for the given (zero based) index, defaults are the same as the resource.
+func (self NamingCounterModel) Value() int64 {
+	start := int64(1)
+	if !self.StartCounter.IsNull() {
+		start = self.StartCounter.ValueInt64()
+	}
+	step := int64(1)
+	if !self.IncrementStep.IsNull() {
+		step = self.IncrementStep.ValueInt64()
+	}
+	return start + self.Index.ValueInt64()*step
+}
+
+func (self *RenderNamingPatternFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "render_naming_pattern"
+}
+
+func (self *RenderNamingPatternFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Render a custom naming pattern",
+		MarkdownDescription: "Render a custom naming pattern (as used by `aria_custom_naming` " +
+			"templates) offline, e.g. to test naming templates with `terraform test`.\n\n" +
+			"Variables (e.g. `${project.name}`) are substituted by their value in the context " +
+			"and counters (`###` or `${###}`) are replaced by the counter's value, zero-padded " +
+			"to the number of `#`. Malformed patterns and unknown variables are rejected.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "Naming pattern (e.g. `${project.name}-${resource.name}-###`)",
+			},
+			function.DynamicParameter{
+				Name: "context",
+				MarkdownDescription: "Values of the variables, either nested " +
+					"(e.g. `{ project = { name = \"demo\" } }`) or flat " +
+					"(e.g. `{ \"project.name\" = \"demo\" }`)",
+			},
+			function.ObjectParameter{
+				Name: "counter",
+				MarkdownDescription: "Counter with `start_counter` and `increment_step` (as in " +
+					"the template, `null` for the default `1`) and the (zero based) `index` of the " +
+					"name to generate. May be `null` to render the first name with the defaults.",
+				AttributeTypes: map[string]attr.Type{
+					"start_counter":  types.Int64Type,
+					"increment_step": types.Int64Type,
+					"index":          types.Int64Type,
+				},
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *RenderNamingPatternFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var pattern string
+	var variables types.Dynamic
+	var counterObject types.Object
+	resp.Error = function.ConcatFuncErrors(
+		resp.Error, req.Arguments.Get(ctx, &pattern, &variables, &counterObject))
+	if resp.Error != nil {
+		return
+	}
+
+	variablesRaw, err := AttrValueToAny(variables)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid context: %s", err))
+		return
+	}
+	flatVariables := map[string]string{}
+	if err := flattenNamingVariables("", variablesRaw, flatVariables); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid context: %s", err))
+		return
+	}
+
+	counter := NamingCounterModel{}
+	if !counterObject.IsNull() {
+		diags := counterObject.As(ctx, &counter, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+	}
+
+	name, err := RenderNamingPattern(pattern, flatVariables, counter.Value())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, name))
+}
+
+// Render a custom naming pattern.
+// Variables (${a.b}) are replaced by their value and counters (### or ${###}) by the counter's
+// value, zero-padded to the number of #.
+func RenderNamingPattern(pattern string, variables map[string]string, counter int64) (string, error) {
+	var name strings.Builder
+	for index := 0; index < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[index:], "${"):
+			end := strings.IndexByte(pattern[index:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("Unclosed variable at position %d of pattern %q", index, pattern)
+			}
+			token := pattern[index+2 : index+end]
+			if nested := strings.Index(token, "${"); nested >= 0 {
+				return "", fmt.Errorf(
+					"Nested variable at position %d of pattern %q", index+2+nested, pattern)
+			}
+			switch {
+			case len(strings.TrimSpace(token)) == 0:
+				return "", fmt.Errorf("Empty variable at position %d of pattern %q", index, pattern)
+			case strings.Trim(token, "#") == "":
+				name.WriteString(formatNamingCounter(counter, len(token)))
+			default:
+				value, ok := variables[strings.TrimSpace(token)]
+				if !ok {
+					return "", fmt.Errorf(
+						"Unknown variable ${%s} at position %d of pattern %q (known variables: %s)",
+						token, index, pattern, strings.Join(slices.Sorted(maps.Keys(variables)), ", "))
+				}
+				name.WriteString(value)
+			}
+			index += end + 1
+		case pattern[index] == '#':
+			width := len(pattern[index:]) - len(strings.TrimLeft(pattern[index:], "#"))
+			name.WriteString(formatNamingCounter(counter, width))
+			index += width
+		case pattern[index] == '}':
+			return "", fmt.Errorf("Unexpected } at position %d of pattern %q", index, pattern)
+		default:
+			name.WriteByte(pattern[index])
+			index++
+		}
+	}
+	return name.String(), nil
+}
+
+func formatNamingCounter(counter int64, width int) string {
+	return fmt.Sprintf("%0*d", width, counter)
+}
+
+// Flatten the (nested) context into dotted variable names (e.g. project.name).
+func flattenNamingVariables(prefix string, value any, variables map[string]string) error {
+	switch value := value.(type) {
+	case nil:
+		if len(prefix) > 0 {
+			variables[prefix] = ""
+		}
+	case map[string]any:
+		for key, item := range value {
+			if len(prefix) > 0 {
+				key = prefix + "." + key
+			}
+			if err := flattenNamingVariables(key, item, variables); err != nil {
+				return err
+			}
+		}
+	case []any:
+		return fmt.Errorf("%s: lists are not supported", prefix)
+	default:
+		if len(prefix) == 0 {
+			return fmt.Errorf("must be an object or a map, got %v", value)
+		}
+		variables[prefix] = fmt.Sprint(value)
+	}
+	return nil
+}
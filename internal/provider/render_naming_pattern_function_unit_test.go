@@ -0,0 +1,74 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderNamingPattern(t *testing.T) {
+	variables := map[string]string{"project.name": "demo", "resource.name": "web"}
+	for _, test := range []struct {
+		pattern  string
+		counter  int64
+		expected string
+	}{
+		{"${project.name}-${resource.name}-###", 7, "demo-web-007"},
+		{"${ project.name }${####}", 42, "demo0042"},
+		{"srv-#", 123, "srv-123"},
+		{"static", 1, "static"},
+	} {
+		name, err := RenderNamingPattern(test.pattern, variables, test.counter)
+		if err != nil {
+			t.Fatalf("RenderNamingPattern(%q): %s", test.pattern, err)
+		}
+		CheckEqual(t, name, test.expected)
+	}
+}
+
+func TestRenderNamingPatternErrors(t *testing.T) {
+	variables := map[string]string{"project.name": "demo"}
+	for _, test := range []struct {
+		pattern  string
+		expected string
+	}{
+		{"${project.name", "Unclosed variable at position 0"},
+		{"a-${}", "Empty variable at position 2"},
+		{"${project.${x}}", "Nested variable at position 10"},
+		{"a}", "Unexpected } at position 1"},
+		{"vm-${resource.nam}", "Unknown variable ${resource.nam} at position 3"},
+	} {
+		_, err := RenderNamingPattern(test.pattern, variables, 1)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("RenderNamingPattern(%q): expected error %q, got %v", test.pattern, test.expected, err)
+		}
+	}
+}
+
+func TestNamingCounterModelValue(t *testing.T) {
+	CheckEqual(t, NamingCounterModel{}.Value(), int64(1))
+	counter := NamingCounterModel{
+		StartCounter:  types.Int64Value(10),
+		IncrementStep: types.Int64Value(5),
+		Index:         types.Int64Value(3),
+	}
+	CheckEqual(t, counter.Value(), int64(25))
+}
+
+func TestFlattenNamingVariables(t *testing.T) {
+	variables := map[string]string{}
+	err := flattenNamingVariables("", map[string]any{
+		"project":      map[string]any{"name": "demo", "id": int64(3)},
+		"resource.env": "prod",
+	}, variables)
+	if err != nil {
+		t.Fatalf("flattenNamingVariables: %s", err)
+	}
+	CheckDeepEqual(t, variables, map[string]string{
+		"project.name": "demo", "project.id": "3", "resource.env": "prod",
+	})
+}
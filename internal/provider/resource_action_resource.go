@@ -17,7 +17,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ResourceActionResource{}
+var _ resource.ResourceWithIdentity = &ResourceActionResource{}
 var _ resource.ResourceWithImportState = &ResourceActionResource{}
+var _ resource.ResourceWithUpgradeState = &ResourceActionResource{}
 
 func NewResourceActionResource() resource.Resource {
 	return &ResourceActionResource{}
@@ -44,6 +46,18 @@ func (self *ResourceActionResource) Schema(
 	resp.Schema = ResourceActionSchema()
 }
 
+func (self *ResourceActionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(ResourceActionSchema().Version)
+}
+
+func (self *ResourceActionResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id", "resource_id")
+}
+
 func (self *ResourceActionResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -75,6 +89,7 @@ func (self *ResourceActionResource) Create(
 	// Save resource action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", action.String()))
 }
 
@@ -107,6 +122,7 @@ func (self *ResourceActionResource) Read(
 	// Save updated resource action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *ResourceActionResource) Update(
@@ -132,6 +148,7 @@ func (self *ResourceActionResource) Update(
 	// Save updated resource action into Terraform state
 	resp.Diagnostics.Append(action.FromAPI(ctx, actionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", action.String()))
 }
 
@@ -159,7 +176,7 @@ func (self *ResourceActionResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// FIXME must be filtered by id and projectId
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
 }
 
 // -------------------------------------------------------------------------------------------------
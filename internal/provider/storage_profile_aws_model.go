@@ -0,0 +1,30 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageProfileAWSModel describes the resource data model.
+type StorageProfileAWSModel struct {
+	DeviceType types.String `tfsdk:"device_type"`
+	VolumeType types.String `tfsdk:"volume_type"`
+	IOPS       types.Int32  `tfsdk:"iops"`
+}
+
+func (self *StorageProfileAWSModel) FromAPI(raw StorageProfileAPIModel) diag.Diagnostics {
+	self.DeviceType = types.StringValue(raw.DeviceType)
+	self.VolumeType = StorageProfileStringFromAPI(raw.VolumeType)
+	var diags diag.Diagnostics
+	self.IOPS, diags = StorageProfileInt32FromAPI("iops", raw.IOPS)
+	return diags
+}
+
+func (self StorageProfileAWSModel) ToAPI(raw *StorageProfileAPIModel) {
+	raw.DeviceType = self.DeviceType.ValueString()
+	raw.VolumeType = self.VolumeType.ValueString()
+	raw.IOPS = StorageProfileInt32ToAPI(self.IOPS)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageProfileAzureModel describes the resource data model.
+type StorageProfileAzureModel struct {
+	DiskType         types.String `tfsdk:"disk_type"`
+	StorageAccountId types.String `tfsdk:"storage_account_id"`
+	DataDiskCaching  types.String `tfsdk:"data_disk_caching"`
+	OSDiskCaching    types.String `tfsdk:"os_disk_caching"`
+}
+
+func (self *StorageProfileAzureModel) FromAPI(raw StorageProfileAPIModel) {
+	// Storage account may only be returned as a link
+	storageAccountId := raw.StorageAccountId
+	if len(storageAccountId) == 0 {
+		storageAccountId = IaaSLinkId(raw.Links, "storage-account")
+	}
+	self.DiskType = types.StringValue(raw.DiskType)
+	self.StorageAccountId = StorageProfileStringFromAPI(storageAccountId)
+	self.DataDiskCaching = types.StringValue(raw.DataDiskCaching)
+	self.OSDiskCaching = types.StringValue(raw.OSDiskCaching)
+}
+
+func (self StorageProfileAzureModel) ToAPI(raw *StorageProfileAPIModel) {
+	raw.DiskType = self.DiskType.ValueString()
+	raw.StorageAccountId = self.StorageAccountId.ValueString()
+	raw.DataDiskCaching = self.DataDiskCaching.ValueString()
+	raw.OSDiskCaching = self.OSDiskCaching.ValueString()
+}
@@ -0,0 +1,204 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageProfileModel describes the resource data model.
+type StorageProfileModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	RegionId           types.String `tfsdk:"region_id"`
+	CloudType          types.String `tfsdk:"cloud_type"`
+	DefaultItem        types.Bool   `tfsdk:"default_item"`
+	SupportsEncryption types.Bool   `tfsdk:"supports_encryption"`
+
+	Tags types.Set `tfsdk:"tags"` // Of type IaaSTagModel
+
+	// Only the one matching the cloud type is set
+	VSphere *StorageProfileVSphereModel `tfsdk:"vsphere"`
+	AWS     *StorageProfileAWSModel     `tfsdk:"aws"`
+	Azure   *StorageProfileAzureModel   `tfsdk:"azure"`
+
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	CloudAccountId   types.String `tfsdk:"cloud_account_id"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+// StorageProfileAPIModel describes the resource API model (of every cloud type).
+type StorageProfileAPIModel struct {
+	Id                 string `json:"id,omitempty"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	RegionId           string `json:"regionId,omitempty"`
+	DefaultItem        bool   `json:"defaultItem"`
+	SupportsEncryption bool   `json:"supportsEncryption"`
+
+	Tags []TagAPIModel `json:"tags"`
+
+	// vSphere
+	DatastoreId      string `json:"datastoreId,omitempty"`
+	StoragePolicyId  string `json:"storagePolicyId,omitempty"`
+	ProvisioningType string `json:"provisioningType,omitempty"`
+	SharesLevel      string `json:"sharesLevel,omitempty"`
+	Shares           string `json:"shares,omitempty"`
+	LimitIOPS        string `json:"limitIops,omitempty"`
+	DiskMode         string `json:"diskMode,omitempty"`
+
+	// vSphere and Azure
+	DiskType string `json:"diskType,omitempty"`
+
+	// AWS
+	DeviceType string `json:"deviceType,omitempty"`
+	VolumeType string `json:"volumeType,omitempty"`
+	IOPS       string `json:"iops,omitempty"`
+
+	// Azure
+	StorageAccountId string `json:"storageAccountId,omitempty"`
+	DataDiskCaching  string `json:"dataDiskCaching,omitempty"`
+	OSDiskCaching    string `json:"osDiskCaching,omitempty"`
+
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	Links map[string]IaaSLinkAPIModel `json:"_links,omitempty"`
+}
+
+func (self StorageProfileModel) String() string {
+	return fmt.Sprintf(
+		"Storage Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of storage profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self StorageProfileModel) LockKey() string {
+	return "storage-profile-" + self.Id.ValueString()
+}
+
+// Storage profiles are managed through the API specific to their cloud type.
+func (self StorageProfileModel) CreatePath() string {
+	return "iaas/api/storage-profiles-" + self.CloudType.ValueString()
+}
+
+func (self StorageProfileModel) ReadPath() string {
+	return self.CreatePath() + "/" + self.Id.ValueString()
+}
+
+func (self StorageProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self StorageProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *StorageProfileModel) FromAPI(
+	ctx context.Context,
+	raw StorageProfileAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.DefaultItem = types.BoolValue(raw.DefaultItem)
+	self.SupportsEncryption = types.BoolValue(raw.SupportsEncryption)
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// Region is kept as is if not returned by the API
+	if regionId := IaaSRegionId(raw.RegionId, raw.Links); len(regionId) > 0 {
+		self.RegionId = types.StringValue(regionId)
+	}
+
+	tags, diags := IaaSTagsFromAPI(ctx, raw.Tags)
+	self.Tags = tags
+
+	// Cloud type is known (from the configuration or the import ID), not returned by the API
+	self.VSphere = nil
+	self.AWS = nil
+	self.Azure = nil
+	switch self.CloudType.ValueString() {
+	case "vsphere":
+		self.VSphere = &StorageProfileVSphereModel{}
+		diags.Append(self.VSphere.FromAPI(raw)...)
+	case "aws":
+		self.AWS = &StorageProfileAWSModel{}
+		diags.Append(self.AWS.FromAPI(raw)...)
+	case "azure":
+		self.Azure = &StorageProfileAzureModel{}
+		self.Azure.FromAPI(raw)
+	}
+
+	return diags
+}
+
+func (self StorageProfileModel) ToAPI(
+	ctx context.Context,
+) (StorageProfileAPIModel, diag.Diagnostics) {
+	tagsRaw, diags := IaaSTagsToAPI(ctx, self.Tags)
+	raw := StorageProfileAPIModel{
+		Name:               self.Name.ValueString(),
+		Description:        CleanString(self.Description.ValueString()),
+		RegionId:           self.RegionId.ValueString(),
+		DefaultItem:        self.DefaultItem.ValueBool(),
+		SupportsEncryption: self.SupportsEncryption.ValueBool(),
+		Tags:               tagsRaw,
+	}
+	// The schema ensures only the one matching the cloud type is set
+	if self.VSphere != nil {
+		self.VSphere.ToAPI(&raw)
+	}
+	if self.AWS != nil {
+		self.AWS.ToAPI(&raw)
+	}
+	if self.Azure != nil {
+		self.Azure.ToAPI(&raw)
+	}
+	return raw, diags
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Convert a number returned as a string by the API (0 if empty).
+func StorageProfileInt32FromAPI(name string, value string) (types.Int32, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if len(value) == 0 {
+		return types.Int32Value(0), diags
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		diags.AddError(
+			"Unable to read storage profile",
+			fmt.Sprintf("Unable to parse %s %q, got error: %s", name, value, err))
+	}
+	return types.Int32Value(int32(number)), diags
+}
+
+// Convert a number to a string for the API (empty if 0).
+func StorageProfileInt32ToAPI(value types.Int32) string {
+	if value.ValueInt32() == 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(value.ValueInt32()), 10)
+}
+
+// Return a null string if the value is empty.
+func StorageProfileStringFromAPI(value string) types.String {
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
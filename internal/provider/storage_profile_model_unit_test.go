@@ -0,0 +1,105 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStorageProfileModelVSphereAPI(t *testing.T) {
+	ctx := t.Context()
+	profile := StorageProfileModel{CloudType: types.StringValue("vsphere")}
+	diags := profile.FromAPI(ctx, StorageProfileAPIModel{
+		Id:               "profile-1",
+		Name:             "Datastores",
+		ProvisioningType: "thin",
+		SharesLevel:      "custom",
+		Shares:           "2000",
+		DiskMode:         "dependent",
+		DiskType:         "standard",
+		Tags:             []TagAPIModel{{Key: "tier", Value: "gold"}},
+		Links: map[string]IaaSLinkAPIModel{
+			"region":    {Href: "/iaas/api/regions/region-1"},
+			"datastore": {Href: "/iaas/api/fabric-vsphere-datastores/datastore-1"},
+		},
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, profile.RegionId.ValueString(), "region-1")
+	CheckEqual(t, profile.AWS == nil && profile.Azure == nil, true)
+	CheckEqual(t, profile.VSphere.DatastoreId.ValueString(), "datastore-1")
+	CheckEqual(t, profile.VSphere.StoragePolicyId.IsNull(), true)
+	CheckEqual(t, profile.VSphere.Shares.ValueInt32(), int32(2000))
+	CheckEqual(t, profile.VSphere.LimitIOPS.ValueInt32(), int32(0))
+	CheckEqual(t, len(profile.Tags.Elements()), 1)
+
+	// Unset numbers are not sent
+	raw, diags := profile.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, raw.DatastoreId, "datastore-1")
+	CheckEqual(t, raw.Shares, "2000")
+	CheckEqual(t, raw.LimitIOPS, "")
+	CheckEqual(t, raw.DeviceType, "")
+
+	// Numbers are returned as strings
+	diags = profile.FromAPI(ctx, StorageProfileAPIModel{Id: "profile-1", LimitIOPS: "many"})
+	CheckDiagnostics(t, diags, "", "Unable to parse limitIops")
+}
+
+func TestStorageProfileModelAWSAPI(t *testing.T) {
+	ctx := t.Context()
+	profile := StorageProfileModel{CloudType: types.StringValue("aws")}
+	diags := profile.FromAPI(ctx, StorageProfileAPIModel{
+		Id:         "profile-2",
+		RegionId:   "region-2",
+		DeviceType: "ebs",
+		VolumeType: "io2",
+		IOPS:       "3000",
+	})
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, profile.RegionId.ValueString(), "region-2")
+	CheckEqual(t, profile.VSphere == nil && profile.Azure == nil, true)
+	CheckEqual(t, profile.AWS.VolumeType.ValueString(), "io2")
+	CheckEqual(t, profile.AWS.IOPS.ValueInt32(), int32(3000))
+
+	raw, diags := profile.ToAPI(ctx)
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, raw.IOPS, "3000")
+	CheckEqual(t, raw.ProvisioningType, "")
+	CheckDeepEqual(t, raw.Tags, []TagAPIModel{})
+}
+
+func TestStorageProfileImportState(t *testing.T) {
+	ctx := t.Context()
+	schema := StorageProfileSchema()
+	newResponse := func() *resource.ImportStateResponse {
+		return &resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schema,
+				Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+			},
+		}
+	}
+	attributes := []string{"cloud_type", "id"}
+
+	resp := newResponse()
+	ImportStateByCompositeId(ctx, attributes, resource.ImportStateRequest{ID: "aws:profile-2"}, resp)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+	var cloudType, id types.String
+	CheckDiagnostics(t, resp.State.GetAttribute(ctx, path.Root("cloud_type"), &cloudType), "", "")
+	CheckDiagnostics(t, resp.State.GetAttribute(ctx, path.Root("id"), &id), "", "")
+	CheckEqual(t, cloudType.ValueString(), "aws")
+	CheckEqual(t, id.ValueString(), "profile-2")
+
+	for _, importId := range []string{"profile-2", "aws:", ":profile-2"} {
+		resp = newResponse()
+		ImportStateByCompositeId(ctx, attributes, resource.ImportStateRequest{ID: importId}, resp)
+		CheckDiagnostics(t, resp.Diagnostics, "", "Expected import identifier with format cloud_type:id")
+	}
+}
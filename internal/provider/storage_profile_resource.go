@@ -0,0 +1,18 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+func NewStorageProfileResource() resource.Resource {
+	return &GenericResource[StorageProfileModel, *StorageProfileModel, StorageProfileAPIModel]{
+		config: GenericResourceConfig{
+			TypeName:           "_storage_profile",
+			SchemaFunc:         StorageProfileSchema,
+			UpdateMethod:       "PATCH",
+			IdentityAttributes: []string{"cloud_type", "id"},
+			ImportIdAttributes: []string{"cloud_type", "id"},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_storage_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_STORAGE_PROFILE"
+  description = "Temporary storage profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+  cloud_type  = "vsphere"
+  vsphere     = {}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_storage_profile.test", "id"),
+					resource.TestCheckResourceAttr(
+						"aria_storage_profile.test", "name", "ARIA_PROVIDER_TEST_STORAGE_PROFILE"),
+					resource.TestCheckResourceAttr("aria_storage_profile.test", "default_item", "false"),
+					resource.TestCheckResourceAttr(
+						"aria_storage_profile.test", "vsphere.provisioning_type", "thin"),
+					resource.TestCheckResourceAttr("aria_storage_profile.test", "tags.#", "0"),
+					resource.TestCheckNoResourceAttr("aria_storage_profile.test", "aws"),
+					resource.TestCheckResourceAttrSet("aria_storage_profile.test", "org_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region where to generate test resources."
+  type        = string
+}
+
+resource "aria_storage_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_STORAGE_PROFILE"
+  description = "Temporary storage profile generated by Aria provider's acceptance tests."
+  region_id   = var.test_region_id
+  cloud_type  = "vsphere"
+
+  vsphere = {
+    provisioning_type = "thick"
+    shares_level      = "custom"
+    shares            = 1500
+    limit_iops        = 500
+  }
+
+  tags = [
+    { key = "ARIA_PROVIDER_TEST_STORAGE_PROFILE", value = "storage" }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aria_storage_profile.test", "vsphere.provisioning_type", "thick"),
+					resource.TestCheckResourceAttr("aria_storage_profile.test", "vsphere.shares", "1500"),
+					resource.TestCheckResourceAttr("aria_storage_profile.test", "vsphere.limit_iops", "500"),
+					resource.TestCheckResourceAttr("aria_storage_profile.test", "tags.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:        "aria_storage_profile.test",
+				ImportState:         true,
+				ImportStateIdPrefix: "vsphere:",
+				ImportStateVerify:   true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
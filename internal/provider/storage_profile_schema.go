@@ -0,0 +1,279 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Cloud types, also the name of the attribute with the settings specific to the cloud type.
+var STORAGE_PROFILE_CLOUD_TYPES = []string{"vsphere", "aws", "azure"}
+
+func StorageProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Storage profile resource, storage settings of a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"description": RequiredDescriptionSchema(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the cloud of the region, either `vsphere`, " +
+					"`aws` or `azure`, the attribute of the same name must be set" + IMMUTABLE,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(STORAGE_PROFILE_CLOUD_TYPES...),
+					StorageProfileCloudTypeValidator{},
+				},
+			},
+			"default_item": schema.BoolAttribute{
+				MarkdownDescription: "Default storage profile of the region (defaults to false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"supports_encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether the storage supports encryption (defaults to false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": IaaSTagsSchema(
+				"Tags of the storage profile, matched by the storage constraints of the " +
+					"cloud templates"),
+			"vsphere": StorageProfileVSphereSchema(),
+			"aws":     StorageProfileAWSSchema(),
+			"azure":   StorageProfileAzureSchema(),
+			"external_region_id": ComputedIdentifierSchema(
+				"Identifier of the region on the cloud provider side"),
+			"cloud_account_id": ComputedIdentifierSchema("Cloud account identifier"),
+			"org_id":           ComputedOrganizationIdSchema(),
+		},
+	}
+}
+
+func StorageProfileVSphereSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Settings of vSphere storage (cloud type `vsphere`)",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				MarkdownDescription: "Datastore (or datastore cluster) identifier",
+				Optional:            true,
+			},
+			"storage_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Storage policy identifier",
+				Optional:            true,
+			},
+			"provisioning_type": schema.StringAttribute{
+				MarkdownDescription: "Disk provisioning type, either `thin` (the default), " +
+					"`thick` or `eagerZeroedThick`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("thin"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("thin", "thick", "eagerZeroedThick"),
+				},
+			},
+			"shares_level": schema.StringAttribute{
+				MarkdownDescription: "Shares level, either `low`, `normal` (the default), " +
+					"`high` or `custom`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("normal"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("low", "normal", "high", "custom"),
+				},
+			},
+			"shares": schema.Int32Attribute{
+				MarkdownDescription: "Number of shares, for the `custom` shares level " +
+					"(defaults to 0, not set)",
+				Optional: true,
+				Computed: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"limit_iops": schema.Int32Attribute{
+				MarkdownDescription: "Maximum IOPS of the disks (defaults to 0, unlimited)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"disk_mode": schema.StringAttribute{
+				MarkdownDescription: "Disk mode, either `dependent` (the default), " +
+					"`independent-persistent` or `independent-nonpersistent`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("dependent"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"dependent", "independent-persistent", "independent-nonpersistent"),
+				},
+			},
+			"disk_type": schema.StringAttribute{
+				MarkdownDescription: "Disk type, either `standard` (the default) or " +
+					"`firstClass` (first class disks)",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("standard"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "firstClass"),
+				},
+			},
+		},
+	}
+}
+
+func StorageProfileAWSSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Settings of AWS storage (cloud type `aws`)",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"device_type": schema.StringAttribute{
+				MarkdownDescription: "Device type, either `ebs` (the default) or `instance-store`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ebs"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ebs", "instance-store"),
+				},
+			},
+			"volume_type": schema.StringAttribute{
+				MarkdownDescription: "Volume type of the EBS devices (e.g. `gp3`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gp2", "gp3", "io1", "io2", "sc1", "st1", "standard"),
+				},
+			},
+			"iops": schema.Int32Attribute{
+				MarkdownDescription: "Provisioned IOPS of the EBS devices (defaults to 0, not set)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func StorageProfileAzureSchema() schema.SingleNestedAttribute {
+	caching := []string{"None", "ReadOnly", "ReadWrite"}
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Settings of Azure storage (cloud type `azure`)",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"disk_type": schema.StringAttribute{
+				MarkdownDescription: "Managed disk type (defaults to `Standard_LRS`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Standard_LRS"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Standard_LRS", "StandardSSD_LRS", "StandardSSD_ZRS",
+						"Premium_LRS", "Premium_ZRS", "UltraSSD_LRS"),
+				},
+			},
+			"storage_account_id": schema.StringAttribute{
+				MarkdownDescription: "Storage account identifier (unmanaged disks)",
+				Optional:            true,
+			},
+			"data_disk_caching": schema.StringAttribute{
+				MarkdownDescription: "Caching of the data disks, either `None` (the default), " +
+					"`ReadOnly` or `ReadWrite`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("None"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(caching...),
+				},
+			},
+			"os_disk_caching": schema.StringAttribute{
+				MarkdownDescription: "Caching of the OS disk, either `None` (the default), " +
+					"`ReadOnly` or `ReadWrite`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("None"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(caching...),
+				},
+			},
+		},
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = StorageProfileCloudTypeValidator{}
+
+// StorageProfileCloudTypeValidator ensures only the settings of the cloud type are set.
+type StorageProfileCloudTypeValidator struct{}
+
+func (self StorageProfileCloudTypeValidator) Description(ctx context.Context) string {
+	return "the attribute named after the cloud type must be set, the others must not"
+}
+
+func (self StorageProfileCloudTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return self.Description(ctx)
+}
+
+func (self StorageProfileCloudTypeValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	cloudType := req.ConfigValue.ValueString()
+	for _, name := range STORAGE_PROFILE_CLOUD_TYPES {
+		var settings types.Object
+		diags := req.Config.GetAttribute(ctx, path.Root(name), &settings)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || settings.IsUnknown() {
+			continue
+		}
+		if name == cloudType && settings.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing storage settings",
+				fmt.Sprintf("Attribute %s must be set for cloud type %s", name, cloudType))
+		} else if name != cloudType && !settings.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected storage settings",
+				fmt.Sprintf("Attribute %s must not be set for cloud type %s", name, cloudType))
+		}
+	}
+}
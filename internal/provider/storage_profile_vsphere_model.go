@@ -0,0 +1,57 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageProfileVSphereModel describes the resource data model.
+type StorageProfileVSphereModel struct {
+	DatastoreId      types.String `tfsdk:"datastore_id"`
+	StoragePolicyId  types.String `tfsdk:"storage_policy_id"`
+	ProvisioningType types.String `tfsdk:"provisioning_type"`
+	SharesLevel      types.String `tfsdk:"shares_level"`
+	Shares           types.Int32  `tfsdk:"shares"`
+	LimitIOPS        types.Int32  `tfsdk:"limit_iops"`
+	DiskMode         types.String `tfsdk:"disk_mode"`
+	DiskType         types.String `tfsdk:"disk_type"`
+}
+
+func (self *StorageProfileVSphereModel) FromAPI(raw StorageProfileAPIModel) diag.Diagnostics {
+	// Datastore and storage policy may only be returned as links
+	datastoreId := raw.DatastoreId
+	if len(datastoreId) == 0 {
+		datastoreId = IaaSLinkId(raw.Links, "datastore")
+	}
+	storagePolicyId := raw.StoragePolicyId
+	if len(storagePolicyId) == 0 {
+		storagePolicyId = IaaSLinkId(raw.Links, "storage-policy")
+	}
+	self.DatastoreId = StorageProfileStringFromAPI(datastoreId)
+	self.StoragePolicyId = StorageProfileStringFromAPI(storagePolicyId)
+	self.ProvisioningType = types.StringValue(raw.ProvisioningType)
+	self.SharesLevel = types.StringValue(raw.SharesLevel)
+	self.DiskMode = types.StringValue(raw.DiskMode)
+	self.DiskType = types.StringValue(raw.DiskType)
+
+	var diags, someDiags diag.Diagnostics
+	self.Shares, someDiags = StorageProfileInt32FromAPI("shares", raw.Shares)
+	diags.Append(someDiags...)
+	self.LimitIOPS, someDiags = StorageProfileInt32FromAPI("limitIops", raw.LimitIOPS)
+	diags.Append(someDiags...)
+	return diags
+}
+
+func (self StorageProfileVSphereModel) ToAPI(raw *StorageProfileAPIModel) {
+	raw.DatastoreId = self.DatastoreId.ValueString()
+	raw.StoragePolicyId = self.StoragePolicyId.ValueString()
+	raw.ProvisioningType = self.ProvisioningType.ValueString()
+	raw.SharesLevel = self.SharesLevel.ValueString()
+	raw.Shares = StorageProfileInt32ToAPI(self.Shares)
+	raw.LimitIOPS = StorageProfileInt32ToAPI(self.LimitIOPS)
+	raw.DiskMode = self.DiskMode.ValueString()
+	raw.DiskType = self.DiskType.ValueString()
+}
@@ -0,0 +1,420 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Subscription criteria are boolean expressions evaluated by the event broker, e.g.
+//
+//	event.data.actionName == "Foo" && (event.data.status != 'FAILED' || !event.data.retry)
+//
+// Grammar (by order of precedence):
+//
+//	conditional    = or [ "?" conditional ":" conditional ]
+//	or             = and { "||" and }
+//	and            = not { "&&" not }
+//	not            = "!" not | comparison
+//	comparison     = additive [ ( "==" | "!=" | "===" | "!==" | "<" | "<=" | ">" | ">=" | "in" )
+//	                 additive ]
+//	additive       = multiplicative { ( "+" | "-" ) multiplicative }
+//	multiplicative = operand { ( "*" | "/" | "%" ) operand }
+//	operand        = [ "-" ] primary { "." identifier [ arguments ] | "[" conditional "]" }
+//	primary        = identifier | string | number | "(" conditional ")" | "[" [ list ] "]"
+//	arguments      = "(" [ list ] ")"
+//	list           = conditional { "," conditional }
+
+// SubscriptionCriteriaError reports an error at a given position (0-based) of the expression.
+type SubscriptionCriteriaError struct {
+	Position int
+	Message  string
+}
+
+func (self SubscriptionCriteriaError) Error() string {
+	return fmt.Sprintf("%s at position %d", self.Message, self.Position)
+}
+
+type criteriaTokenKind int
+
+const (
+	CRITERIA_TOKEN_END criteriaTokenKind = iota
+	CRITERIA_TOKEN_IDENTIFIER
+	CRITERIA_TOKEN_NUMBER
+	CRITERIA_TOKEN_STRING
+	CRITERIA_TOKEN_OPERATOR
+)
+
+type criteriaToken struct {
+	Kind     criteriaTokenKind
+	Text     string // Normalized text (strings are double quoted)
+	Position int
+}
+
+// Operators, longest first to match them greedily.
+var criteriaOperators = []string{
+	"===", "!==", "==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ",", ".",
+	"+", "-", "*", "/", "%", "?", ":",
+}
+
+var criteriaComparisonOperators = []string{"==", "!=", "===", "!==", "<", "<=", ">", ">="}
+
+// Parse the subscription criteria expression and return it normalized (whitespaces and quoting).
+// An empty expression (no criteria) is valid.
+func ParseSubscriptionCriteria(expression string) (string, error) {
+	tokens, err := tokenizeSubscriptionCriteria(expression)
+	if err != nil {
+		return "", err
+	}
+	if len(tokens) == 1 {
+		return "", nil
+	}
+	parser := criteriaParser{tokens: tokens}
+	normalized, err := parser.parseConditional()
+	if err != nil {
+		return "", err
+	}
+	if token := parser.peek(); token.Kind != CRITERIA_TOKEN_END {
+		return "", SubscriptionCriteriaError{token.Position, fmt.Sprintf("Unexpected %q", token.Text)}
+	}
+	return normalized, nil
+}
+
+func tokenizeSubscriptionCriteria(expression string) ([]criteriaToken, error) {
+	tokens := []criteriaToken{}
+	for index := 0; index < len(expression); {
+		char := expression[index]
+		switch {
+		case char == ' ' || char == '\t' || char == '\r' || char == '\n':
+			index++
+		case isCriteriaIdentifierChar(char, true):
+			end := index + 1
+			for end < len(expression) && isCriteriaIdentifierChar(expression[end], false) {
+				end++
+			}
+			tokens = append(tokens, criteriaToken{CRITERIA_TOKEN_IDENTIFIER, expression[index:end], index})
+			index = end
+		case char >= '0' && char <= '9':
+			end := index + 1
+			for end < len(expression) && (expression[end] >= '0' && expression[end] <= '9' ||
+				expression[end] == '.') {
+				end++
+			}
+			text := expression[index:end]
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, SubscriptionCriteriaError{index, fmt.Sprintf("Invalid number %q", text)}
+			}
+			tokens = append(tokens, criteriaToken{CRITERIA_TOKEN_NUMBER, text, index})
+			index = end
+		case char == '"' || char == '\'':
+			value, end, err := scanCriteriaString(expression, index)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, criteriaToken{CRITERIA_TOKEN_STRING, strconv.Quote(value), index})
+			index = end
+		default:
+			operator := ""
+			for _, candidate := range criteriaOperators {
+				if strings.HasPrefix(expression[index:], candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if len(operator) == 0 {
+				return nil, SubscriptionCriteriaError{index, fmt.Sprintf("Unexpected character %q", char)}
+			}
+			tokens = append(tokens, criteriaToken{CRITERIA_TOKEN_OPERATOR, operator, index})
+			index += len(operator)
+		}
+	}
+	return append(tokens, criteriaToken{CRITERIA_TOKEN_END, "end of expression", len(expression)}), nil
+}
+
+func isCriteriaIdentifierChar(char byte, first bool) bool {
+	return char == '_' || char == '$' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' ||
+		!first && char >= '0' && char <= '9'
+}
+
+// Scan a single or double quoted string starting at index.
+// Return its (unescaped) value and the index following the closing quote.
+func scanCriteriaString(expression string, index int) (string, int, error) {
+	quote := expression[index]
+	var value strings.Builder
+	for end := index + 1; end < len(expression); end++ {
+		switch expression[end] {
+		case quote:
+			return value.String(), end + 1, nil
+		case '\\':
+			end++
+			if end == len(expression) {
+				break
+			}
+			switch expression[end] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			default:
+				value.WriteByte(expression[end])
+			}
+		default:
+			value.WriteByte(expression[end])
+		}
+	}
+	return "", 0, SubscriptionCriteriaError{index, "Unterminated string"}
+}
+
+// criteriaParser is a recursive descent parser returning the normalized expression.
+type criteriaParser struct {
+	tokens []criteriaToken
+	index  int
+}
+
+func (self *criteriaParser) peek() criteriaToken {
+	return self.tokens[self.index]
+}
+
+func (self *criteriaParser) next() criteriaToken {
+	token := self.tokens[self.index]
+	if token.Kind != CRITERIA_TOKEN_END {
+		self.index++
+	}
+	return token
+}
+
+func (self *criteriaParser) accept(operators ...string) (string, bool) {
+	token := self.peek()
+	if token.Kind == CRITERIA_TOKEN_OPERATOR {
+		for _, operator := range operators {
+			if token.Text == operator {
+				self.index++
+				return operator, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Accept the keyword (an identifier such as in) if it is the next token.
+func (self *criteriaParser) acceptKeyword(keyword string) (string, bool) {
+	token := self.peek()
+	if token.Kind == CRITERIA_TOKEN_IDENTIFIER && token.Text == keyword {
+		self.index++
+		return keyword, true
+	}
+	return "", false
+}
+
+func (self *criteriaParser) expect(operator string) error {
+	if _, ok := self.accept(operator); !ok {
+		token := self.peek()
+		return SubscriptionCriteriaError{
+			token.Position,
+			fmt.Sprintf("Expected %q, got %q", operator, token.Text),
+		}
+	}
+	return nil
+}
+
+func (self *criteriaParser) parseConditional() (string, error) {
+	condition, err := self.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := self.accept("?"); !ok {
+		return condition, nil
+	}
+	consequent, err := self.parseConditional()
+	if err != nil {
+		return "", err
+	}
+	if err := self.expect(":"); err != nil {
+		return "", err
+	}
+	alternative, err := self.parseConditional()
+	if err != nil {
+		return "", err
+	}
+	return condition + " ? " + consequent + " : " + alternative, nil
+}
+
+func (self *criteriaParser) parseOr() (string, error) {
+	return self.parseBinary(self.parseAnd, "||")
+}
+
+func (self *criteriaParser) parseAnd() (string, error) {
+	return self.parseBinary(self.parseNot, "&&")
+}
+
+func (self *criteriaParser) parseBinary(
+	operand func() (string, error),
+	operators ...string,
+) (string, error) {
+	left, err := operand()
+	if err != nil {
+		return "", err
+	}
+	for {
+		operator, ok := self.accept(operators...)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return "", err
+		}
+		left = left + " " + operator + " " + right
+	}
+}
+
+func (self *criteriaParser) parseNot() (string, error) {
+	if _, ok := self.accept("!"); ok {
+		operand, err := self.parseNot()
+		return "!" + operand, err
+	}
+	left, err := self.parseAdditive()
+	if err != nil {
+		return "", err
+	}
+	operator, ok := self.accept(criteriaComparisonOperators...)
+	if !ok {
+		operator, ok = self.acceptKeyword("in")
+	}
+	if ok {
+		right, err := self.parseAdditive()
+		if err != nil {
+			return "", err
+		}
+		return left + " " + operator + " " + right, nil
+	}
+	return left, nil
+}
+
+func (self *criteriaParser) parseAdditive() (string, error) {
+	return self.parseBinary(self.parseMultiplicative, "+", "-")
+}
+
+func (self *criteriaParser) parseMultiplicative() (string, error) {
+	return self.parseBinary(self.parseOperand, "*", "/", "%")
+}
+
+func (self *criteriaParser) parseOperand() (string, error) {
+	sign, _ := self.accept("-")
+	operand, err := self.parsePrimary()
+	if err != nil {
+		return "", err
+	}
+	operand = sign + operand
+	for {
+		if _, ok := self.accept("."); ok {
+			token := self.next()
+			if token.Kind != CRITERIA_TOKEN_IDENTIFIER {
+				return "", SubscriptionCriteriaError{
+					token.Position,
+					fmt.Sprintf("Expected a property name, got %q", token.Text),
+				}
+			}
+			operand += "." + token.Text
+			if _, ok := self.accept("("); ok {
+				arguments, err := self.parseList(")")
+				if err != nil {
+					return "", err
+				}
+				operand += "(" + arguments + ")"
+			}
+		} else if _, ok := self.accept("["); ok {
+			index, err := self.parseConditional()
+			if err != nil {
+				return "", err
+			}
+			if err := self.expect("]"); err != nil {
+				return "", err
+			}
+			operand += "[" + index + "]"
+		} else {
+			return operand, nil
+		}
+	}
+}
+
+func (self *criteriaParser) parsePrimary() (string, error) {
+	token := self.next()
+	switch token.Kind {
+	case CRITERIA_TOKEN_IDENTIFIER, CRITERIA_TOKEN_NUMBER, CRITERIA_TOKEN_STRING:
+		return token.Text, nil
+	case CRITERIA_TOKEN_OPERATOR:
+		switch token.Text {
+		case "(":
+			expression, err := self.parseConditional()
+			if err != nil {
+				return "", err
+			}
+			return "(" + expression + ")", self.expect(")")
+		case "[":
+			items, err := self.parseList("]")
+			return "[" + items + "]", err
+		}
+	}
+	return "", SubscriptionCriteriaError{token.Position, fmt.Sprintf("Unexpected %q", token.Text)}
+}
+
+// Parse a comma separated list of expressions up to (and including) the closing operator.
+func (self *criteriaParser) parseList(closing string) (string, error) {
+	items := []string{}
+	if _, ok := self.accept(closing); ok {
+		return "", nil
+	}
+	for {
+		item, err := self.parseConditional()
+		if err != nil {
+			return "", err
+		}
+		items = append(items, item)
+		if _, ok := self.accept(","); !ok {
+			return strings.Join(items, ", "), self.expect(closing)
+		}
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = SubscriptionCriteriaValidator{}
+
+// SubscriptionCriteriaValidator checks the syntax of subscription criteria at plan time.
+// A warning is reported as the grammar covers only a subset of the expressions accepted by the
+// event broker (JavaScript).
+type SubscriptionCriteriaValidator struct{}
+
+func (self SubscriptionCriteriaValidator) Description(ctx context.Context) string {
+	return "value should be a valid subscription criteria expression"
+}
+
+func (self SubscriptionCriteriaValidator) MarkdownDescription(ctx context.Context) string {
+	return self.Description(ctx)
+}
+
+func (self SubscriptionCriteriaValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := ParseSubscriptionCriteria(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unrecognized subscription criteria",
+			fmt.Sprintf(
+				"Unable to parse criteria %q, got error: %s (sent as is, check its syntax)",
+				req.ConfigValue.ValueString(), err))
+	}
+}
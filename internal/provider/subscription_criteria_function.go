@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SubscriptionCriteriaFunction{}
+
+func NewSubscriptionCriteriaFunction() function.Function {
+	return &SubscriptionCriteriaFunction{}
+}
+
+// SubscriptionCriteriaFunction defines the function implementation.
+type SubscriptionCriteriaFunction struct{}
+
+func (self *SubscriptionCriteriaFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "subscription_criteria"
+}
+
+func (self *SubscriptionCriteriaFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Validate and normalize a subscription criteria expression",
+		MarkdownDescription: "Parse a subscription criteria expression (e.g. " +
+			"`event.data.actionName == \"Foo\" && !event.data.retry`) and return it normalized " +
+			"(single spaces around binary operators, double quoted strings).\n\n" +
+			"Supported syntax: `||`, `&&`, `!`, comparisons (`==`, `!=`, `===`, `!==`, `<`, " +
+			"`<=`, `>`, `>=`), property access (`a.b`, `a[\"b\"]`), method calls " +
+			"(`a.startsWith(\"b\")`), parentheses, lists, strings, numbers and identifiers.\n\n" +
+			"Errors report their (0-based) position in the expression.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Subscription criteria expression",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *SubscriptionCriteriaFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var expression string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := ParseSubscriptionCriteria(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
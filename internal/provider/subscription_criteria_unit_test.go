@@ -0,0 +1,94 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSubscriptionCriteria(t *testing.T) {
+	for _, test := range []struct {
+		expression string
+		expected   string
+	}{
+		{"", ""},
+		{"  \n ", ""},
+		{`event.data.actionName=="Foo"`, `event.data.actionName == "Foo"`},
+		{
+			"event.data.a == 'it\\'s'&&(  event.data.b!=2.5||!event.data.retry )",
+			`event.data.a == "it's" && (event.data.b != 2.5 || !event.data.retry)`,
+		},
+		{
+			"event.data.tags.contains( 'x','y' ) && event.data['name'] >= -1",
+			`event.data.tags.contains("x", "y") && event.data["name"] >= -1`,
+		},
+		{`event.data.ids == [ ]`, `event.data.ids == []`},
+		{"event.data.count+1 > 2*event.data.x%3", "event.data.count + 1 > 2 * event.data.x % 3"},
+		{"event.data.a - -1/2 >= 0", "event.data.a - -1 / 2 >= 0"},
+		{"event.data.x?true:false", "event.data.x ? true : false"},
+		{
+			"event.data.a ? event.data.b ? 1 : 2 : (event.data.c ? 3 : 4)",
+			"event.data.a ? event.data.b ? 1 : 2 : (event.data.c ? 3 : 4)",
+		},
+		{"event.data.x in ['a','b']", `event.data.x in ["a", "b"]`},
+		{`event.data.in == 1`, `event.data.in == 1`},
+	} {
+		normalized, err := ParseSubscriptionCriteria(test.expression)
+		if err != nil {
+			t.Fatalf("ParseSubscriptionCriteria(%q): %s", test.expression, err)
+		}
+		CheckEqual(t, normalized, test.expected)
+	}
+}
+
+func TestParseSubscriptionCriteriaErrors(t *testing.T) {
+	for _, test := range []struct {
+		expression string
+		expected   string
+	}{
+		{`event.data.a == "Foo`, "Unterminated string at position 16"},
+		{`event.data.a = "Foo"`, `Unexpected character '=' at position 13`},
+		{`event.data.a == "Foo" &&`, `Unexpected "end of expression" at position 24`},
+		{`(event.data.a == 1`, `Expected ")", got "end of expression" at position 18`},
+		{`event.data. == 1`, `Expected a property name, got "==" at position 12`},
+		{`event.data.a == 1 event.data.b`, `Unexpected "event" at position 18`},
+		{`event.data.a == 1.2.3`, `Invalid number "1.2.3" at position 16`},
+		{`event.data.a ? 1`, `Expected ":", got "end of expression" at position 16`},
+		{`event.data.a in`, `Unexpected "end of expression" at position 15`},
+		{`event.data.a + * 2`, `Unexpected "*" at position 15`},
+	} {
+		_, err := ParseSubscriptionCriteria(test.expression)
+		if err == nil {
+			t.Errorf("ParseSubscriptionCriteria(%q): expected an error", test.expression)
+			continue
+		}
+		CheckEqual(t, err.Error(), test.expected)
+	}
+}
+
+func TestSubscriptionCriteriaValidator(t *testing.T) {
+	// Unrecognized criteria are reported as warnings (the event broker may accept them)
+	for _, test := range []struct {
+		value    types.String
+		warnings int
+	}{
+		{types.StringValue(`event.data.a == "b"`), 0},
+		{types.StringValue(""), 0},
+		{types.StringNull(), 0},
+		{types.StringUnknown(), 0},
+		{types.StringValue(`event.data.a == `), 1},
+	} {
+		resp := validator.StringResponse{}
+		SubscriptionCriteriaValidator{}.ValidateString(t.Context(), validator.StringRequest{
+			Path:        path.Root("criteria"),
+			ConfigValue: test.value,
+		}, &resp)
+		CheckEqual(t, resp.Diagnostics.HasError(), false)
+		CheckEqual(t, resp.Diagnostics.WarningsCount(), test.warnings)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/list"
+
+func NewSubscriptionListResource() list.ListResource {
+	return &GenericListResource{config: SubscriptionListResourceConfig()}
+}
+
+func SubscriptionListResourceConfig() GenericListResourceConfig {
+	return GenericListResourceConfig{
+		TypeName:    "_subscription",
+		NewResource: NewSubscriptionResource,
+		ListPath:    "event-broker/api/subscriptions",
+		Format:      LIST_FORMAT_CONTENT,
+		Filters: []ListFilter{
+			{
+				Attribute:   "project_id",
+				Description: "List only the subscriptions constrained to this project",
+				Field:       "constraints.projectId",
+			},
+		},
+		ImportKeyFields: []string{"name"},
+	}
+}
@@ -7,14 +7,15 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SubscriptionResource{}
+var _ resource.ResourceWithIdentity = &SubscriptionResource{}
 var _ resource.ResourceWithImportState = &SubscriptionResource{}
+var _ resource.ResourceWithUpgradeState = &SubscriptionResource{}
 
 func NewSubscriptionResource() resource.Resource {
 	return &SubscriptionResource{}
@@ -41,6 +42,18 @@ func (self *SubscriptionResource) Schema(
 	resp.Schema = SubscriptionSchema()
 }
 
+func (self *SubscriptionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(SubscriptionSchema().Version)
+}
+
+func (self *SubscriptionResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *SubscriptionResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -89,6 +102,7 @@ func (self *SubscriptionResource) Create(
 	// Save subscription into Terraform state
 	resp.Diagnostics.Append(subscription.FromAPI(ctx, subscriptionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &subscription)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", subscription.String()))
 }
 
@@ -119,6 +133,7 @@ func (self *SubscriptionResource) Read(
 	// Save updated subscription into Terraform state
 	resp.Diagnostics.Append(subscription.FromAPI(ctx, subscriptionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &subscription)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *SubscriptionResource) Update(
@@ -160,6 +175,7 @@ func (self *SubscriptionResource) Update(
 	// Save subscription into Terraform state
 	resp.Diagnostics.Append(subscription.FromAPI(ctx, subscriptionFromAPI)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &subscription)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", subscription.String()))
 }
 
@@ -181,5 +197,5 @@ func (self *SubscriptionResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ImportStateByIdOrNaturalKey(ctx, self.client, SubscriptionListResourceConfig(), req, resp)
 }
@@ -86,10 +86,14 @@ func SubscriptionSchema() schema.Schema {
 				Required:            true,
 			},
 			"criteria": schema.StringAttribute{
-				MarkdownDescription: "Event filter criteria expression",
-				Computed:            true,
-				Optional:            true,
-				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Event filter criteria expression (syntax checked at plan time, " +
+					"see the `subscription_criteria` function)",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					SubscriptionCriteriaValidator{},
+				},
 			},
 			"disabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the subscription is disabled (default `false`)",
@@ -71,6 +71,10 @@ func (self TagModel) DeletePath() string {
 	return path
 }
 
+func (self TagModel) IsForceDelete() bool {
+	return self.ForceDelete.ValueBool()
+}
+
 func (self *TagModel) FromAPI(raw TagAPIModel) {
 	self.Id = types.StringValue(raw.Id)
 	self.Key = types.StringValue(raw.Key)
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,7 +15,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TagResource{}
+var _ resource.ResourceWithIdentity = &TagResource{}
 var _ resource.ResourceWithImportState = &TagResource{}
+var _ resource.ResourceWithUpgradeState = &TagResource{}
 
 func NewTagResource() resource.Resource {
 	return &TagResource{}
@@ -41,6 +44,18 @@ func (self *TagResource) Schema(
 	resp.Schema = TagSchema()
 }
 
+func (self *TagResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(TagSchema().Version)
+}
+
+func (self *TagResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema("id")
+}
+
 func (self *TagResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -71,6 +86,7 @@ func (self *TagResource) Create(
 	// Save tag into Terraform state
 	tag.FromAPI(tagFromAPI)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tag)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", tag.String()))
 }
 
@@ -123,6 +139,7 @@ func (self *TagResource) Read(
 	// Save updated tag into Terraform state
 	tag.FromAPI(listFromAPI.Content[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tag)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *TagResource) Update(
@@ -139,6 +156,7 @@ func (self *TagResource) Update(
 
 	// Save updated tag into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tag)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", tag.String()))
 }
 
@@ -160,7 +178,40 @@ func (self *TagResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	key, value, found := strings.Cut(req.ID, ":")
+	if !found {
+		ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+	} else {
+		// Import by natural key, resolve the identifier by filtering tag list by key and value
+		var tag TagModel
+		var listFromAPI TagListAPIModel
+		listPath := tag.ListPath()
+		filter := fmt.Sprintf("key eq %s and value eq %s", ODataString(key), ODataString(value))
+		response, err := self.client.R(listPath).
+			SetQueryParam("$filter", filter).
+			SetQueryParam("$top", "2"). // Make it possible to know if filter works properly
+			SetResult(&listFromAPI).
+			Get(listPath)
+		err = self.client.HandleAPIResponse(response, err, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to list tags to import %s, got error: %s", req.ID, err))
+			return
+		}
+		if len(listFromAPI.Content) != 1 {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf(
+					"Expected one and only one tag matching %s, found: %d",
+					req.ID, len(listFromAPI.Content),
+				),
+			)
+			return
+		}
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), listFromAPI.Content[0].Id)...)
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_delete"), false)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keep_on_destroy"), false)...)
 }
@@ -358,6 +358,41 @@ func (r *CleanupRunner) Projects() {
 	)
 }
 
+// CloudZones deletes cloud zones whose name starts with TestPrefix.
+func (r *CleanupRunner) CloudZones() {
+	r.applyCleanups(
+		r.contentCleanupsByPrefix("iaas/api/zones", "name"),
+	)
+}
+
+// FlavorProfiles deletes flavor profiles whose name starts with TestPrefix.
+func (r *CleanupRunner) FlavorProfiles() {
+	r.applyCleanups(
+		r.contentCleanupsByPrefix("iaas/api/flavor-profiles", "name"),
+	)
+}
+
+// ImageProfiles deletes image profiles whose name starts with TestPrefix.
+func (r *CleanupRunner) ImageProfiles() {
+	r.applyCleanups(
+		r.contentCleanupsByPrefix("iaas/api/image-profiles", "name"),
+	)
+}
+
+// NetworkProfiles deletes network profiles whose name starts with TestPrefix.
+func (r *CleanupRunner) NetworkProfiles() {
+	r.applyCleanups(
+		r.contentCleanupsByPrefix("iaas/api/network-profiles", "name"),
+	)
+}
+
+// StorageProfiles deletes storage profiles whose name starts with TestPrefix.
+func (r *CleanupRunner) StorageProfiles() {
+	r.applyCleanups(
+		r.contentCleanupsByPrefix("iaas/api/storage-profiles", "name"),
+	)
+}
+
 // CustomNamings deletes custom naming rules whose name starts with TestPrefix.
 func (r *CleanupRunner) CustomNamings() {
 	r.applyCleanups(
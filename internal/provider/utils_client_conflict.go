@@ -0,0 +1,164 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Delay between two attempts to delete an instance when the API responds with a conflict.
+var conflictRetryDelay = 3 * time.Second
+
+// Stop retrying to delete an instance when the same references are blocking it for this amount of
+// attempts. The conflict is not being resolved by concurrent deletes (of the referencing objects).
+const CONFLICT_STALL_MAX_ATTEMPTS = 20
+
+// ConflictReference describes an object blocking the deletion of an instance.
+type ConflictReference struct {
+	Type string
+	Name string
+	Id   string
+}
+
+// ConflictAPIModel describes the relevant content of a conflict (HTTP 409) response.
+type ConflictAPIModel struct {
+	Message    string
+	References []ConflictReference
+}
+
+// Keys (lower case) of conflict payloads under which blocking references are listed.
+var conflictReferencesKeys = []string{
+	"references", "referencedby", "referencingobjects", "referencingelements",
+	"dependencies", "dependents", "usages", "usedby", "inuseby", "relations",
+}
+
+// Keys (lower case) of reference objects holding type, name and identifier (by priority).
+var conflictTypeKeys = []string{"type", "objecttype", "resourcetype", "kind"}
+var conflictNameKeys = []string{"name", "displayname", "fqn", "key"}
+var conflictIdKeys = []string{"id", "objectid", "uuid", "resourceid"}
+
+// Optional interface for models that can be deleted bypassing the references check.
+type ForceDeletableModel interface {
+	IsForceDelete() bool
+}
+
+func (self ConflictReference) String() string {
+	parts := SkipEmpty([]string{self.Type, self.Name})
+	if len(parts) == 0 {
+		parts = []string{"Object"}
+	}
+	text := strings.Join(parts, " ")
+	if len(self.Id) > 0 {
+		text += " (" + self.Id + ")"
+	}
+	return text
+}
+
+// Parse a conflict response body, it's done on a best effort basis as every service of the
+// platform has its own way of reporting the objects that are blocking the operation.
+func ParseConflict(body []byte) ConflictAPIModel {
+	conflict := ConflictAPIModel{}
+	var data any
+	if json.Unmarshal(body, &data) != nil {
+		conflict.Message = strings.TrimSpace(string(body))
+		return conflict
+	}
+
+	if object, ok := data.(map[string]any); ok {
+		for _, key := range []string{"message", "errorMessage", "error", "detail"} {
+			if text, ok := object[key].(string); ok && len(text) > 0 {
+				conflict.Message = text
+				break
+			}
+		}
+	}
+
+	// Sorted to make diagnostics stable (JSON objects are unordered)
+	conflict.References = collectConflictReferences(data, false)
+	slices.SortFunc(conflict.References, func(a ConflictReference, b ConflictReference) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return conflict
+}
+
+// Return a text uniquely identifying the conflict's references (to detect changes).
+func (self ConflictAPIModel) Signature() string {
+	items := make([]string, 0, len(self.References))
+	for _, reference := range self.References {
+		items = append(items, reference.String())
+	}
+	return strings.Join(items, "\n")
+}
+
+// Return a human readable description of the conflict (with a hint if instance can be forced).
+func (self ConflictAPIModel) Describe(instance Model) string {
+	lines := []string{}
+	if len(self.References) > 0 {
+		lines = append(lines, fmt.Sprintf("%s is referenced by:", instance.String()))
+		for _, reference := range self.References {
+			lines = append(lines, "* "+reference.String())
+		}
+	}
+	if forceable, ok := instance.(ForceDeletableModel); ok && !forceable.IsForceDelete() {
+		lines = append(lines,
+			"Delete or update the referencing objects first, or set force_delete = true "+
+				"to bypass the references check (use with caution).")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func collectConflictReferences(data any, inReferences bool) []ConflictReference {
+	references := []ConflictReference{}
+	switch value := data.(type) {
+	case map[string]any:
+		if inReferences {
+			if reference, ok := conflictReferenceFromMap(value); ok {
+				return append(references, reference)
+			}
+		}
+		for key, item := range value {
+			isReferences := slices.Contains(conflictReferencesKeys, strings.ToLower(key))
+			references = append(references, collectConflictReferences(item, isReferences)...)
+		}
+	case []any:
+		for _, item := range value {
+			references = append(references, collectConflictReferences(item, inReferences)...)
+		}
+	case string:
+		if inReferences {
+			references = append(references, ConflictReference{Name: value})
+		}
+	}
+	return references
+}
+
+func conflictReferenceFromMap(data map[string]any) (ConflictReference, bool) {
+	texts := map[string]string{}
+	for key, value := range data {
+		if text, ok := value.(string); ok {
+			texts[strings.ToLower(key)] = text
+		}
+	}
+
+	// First non-empty value, keys are sorted by priority
+	first := func(keys []string) string {
+		for _, key := range keys {
+			if text := texts[key]; len(text) > 0 {
+				return text
+			}
+		}
+		return ""
+	}
+
+	reference := ConflictReference{
+		Type: first(conflictTypeKeys),
+		Name: first(conflictNameKeys),
+		Id:   first(conflictIdKeys),
+	}
+	return reference, len(reference.Name) > 0 || len(reference.Id) > 0
+}
@@ -0,0 +1,152 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// withoutConflictRetryDelay makes DeleteIt retry conflicts immediately for the duration of the test.
+func withoutConflictRetryDelay(t *testing.T) {
+	delay := conflictRetryDelay
+	conflictRetryDelay = 0
+	t.Cleanup(func() { conflictRetryDelay = delay })
+}
+
+func TestParseConflict(t *testing.T) {
+	conflict := ParseConflict([]byte(`{
+		"status": 409,
+		"message": "Action is referenced by other elements",
+		"references": [
+			{"type": "Workflow", "name": "Provision VM", "id": "wf-2"},
+			{"type": "Action", "fqn": "ch.ocsin.core/getHost", "id": "action-1"}
+		]
+	}`))
+	CheckEqual(t, conflict.Message, "Action is referenced by other elements")
+	CheckDeepEqual(t, conflict.References, []ConflictReference{
+		{Type: "Action", Name: "ch.ocsin.core/getHost", Id: "action-1"},
+		{Type: "Workflow", Name: "Provision VM", Id: "wf-2"},
+	})
+	CheckEqual(t, conflict.Signature(),
+		"Action ch.ocsin.core/getHost (action-1)\nWorkflow Provision VM (wf-2)")
+}
+
+func TestParseConflictNested(t *testing.T) {
+	conflict := ParseConflict([]byte(`{
+		"errorMessage": "Tag is in use",
+		"details": {"usedBy": [{"resourceType": "Cloud Zone", "displayName": "zone-a"}, "vm-1"]}
+	}`))
+	CheckEqual(t, conflict.Message, "Tag is in use")
+	CheckDeepEqual(t, conflict.References, []ConflictReference{
+		{Type: "Cloud Zone", Name: "zone-a"},
+		{Name: "vm-1"},
+	})
+}
+
+func TestParseConflictNotJSON(t *testing.T) {
+	conflict := ParseConflict([]byte("  Conflict  "))
+	CheckEqual(t, conflict.Message, "Conflict")
+	CheckEqual(t, len(conflict.References), 0)
+	CheckEqual(t, conflict.Signature(), "")
+}
+
+func TestConflictDescribe(t *testing.T) {
+	conflict := ConflictAPIModel{References: []ConflictReference{{Type: "Workflow", Id: "wf-2"}}}
+
+	tag := &TagModel{Id: types.StringValue("tag-1"), Key: types.StringValue("env")}
+	description := conflict.Describe(tag)
+	CheckEqual(t, description, strings.Join([]string{
+		"Tag tag-1 (env) is referenced by:",
+		"* Workflow (wf-2)",
+		"Delete or update the referencing objects first, or set force_delete = true " +
+			"to bypass the references check (use with caution).",
+	}, "\n"))
+
+	// No hint when already forced or when the resource does not support it
+	tag.ForceDelete = types.BoolValue(true)
+	CheckEqual(t, strings.Contains(conflict.Describe(tag), "force_delete"), false)
+	CheckEqual(t, strings.Contains(conflict.Describe(taskModel("t")), "force_delete"), false)
+}
+
+func TestAriaClientDeleteItConflictConverges(t *testing.T) {
+	withoutConflictRetryDelay(t)
+	deletes := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			deletes++
+			if deletes < 3 {
+				writeJSONStatus(w, http.StatusConflict, map[string]any{
+					"message": "in use",
+					"references": []any{
+						map[string]any{"type": "Task", "id": fmt.Sprintf("task-%d", deletes)},
+					},
+				})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			writeJSONStatus(w, http.StatusNotFound, map[string]any{"message": "gone"})
+		}
+	})
+	client := newTestClient(t, server.URL)
+
+	diags := client.DeleteIt(taskModel("task-123"))
+	if diags.HasError() {
+		t.Fatalf("DeleteIt: %v", diags.Errors())
+	}
+	CheckEqual(t, deletes, 3)
+}
+
+func TestAriaClientDeleteItConflictStalled(t *testing.T) {
+	withoutConflictRetryDelay(t)
+	deletes := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/iaas/api/tags/tag-1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		deletes++
+		writeJSONStatus(w, http.StatusConflict, map[string]any{
+			"message":    "Tag is in use",
+			"references": []any{map[string]any{"type": "Project", "name": "Team A", "id": "p-1"}},
+		})
+	})
+	client := newTestClient(t, server.URL)
+
+	diags := client.DeleteIt(&TagModel{Id: types.StringValue("tag-1"), Key: types.StringValue("env")})
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic when the conflict is not resolved")
+	}
+	CheckEqual(t, deletes, CONFLICT_STALL_MAX_ATTEMPTS+1)
+
+	detail := diags.Errors()[0].Detail()
+	for _, expected := range []string{
+		"the blocking references did not change",
+		"* Project Team A (p-1)",
+		"set force_delete = true",
+	} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("%q not found in error %q", expected, detail)
+		}
+	}
+}
+
+func TestAriaClientDeleteItConflictMaxAttempts(t *testing.T) {
+	withoutConflictRetryDelay(t)
+	deletes := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		deletes++
+		writeJSONStatus(w, http.StatusConflict, map[string]any{"message": "busy"})
+	})
+	client := newTestClient(t, server.URL)
+
+	diags := client.DeleteIt(taskModel("task-123"), 2)
+	CheckDiagnostics(t, diags, "", "Unable to delete Orchestrator Task task-123")
+	CheckEqual(t, deletes, 3)
+}
@@ -26,9 +26,21 @@ type AriaClient struct {
 	// Host must be a the URL to the base of the API.
 	Host string
 
+	// Other nodes of the appliance, requests fail over to them if host is unavailable.
+	FallbackHosts []string
+
+	// Hosts (primary and fallbacks) with their health, set by Init if there are fallback hosts.
+	Hosts *HostPool
+
 	RefreshToken string `datapolicy:"token"`
 	AccessToken  string `datapolicy:"token"`
 
+	// Directory where access tokens are cached across runs (disabled if empty).
+	TokenCacheDir string
+
+	// Renews the cached access token if rejected, set by Init if the cache is enabled.
+	TokenRenewal *TokenRenewalTransport
+
 	OKAPICallsLogLevel string
 	KOAPICallsLogLevel string
 
@@ -65,6 +77,13 @@ func (self *AriaClient) Init() diag.Diagnostics {
 	if len(self.AccessToken) > 0 {
 		client.SetAuthToken(self.AccessToken)
 	}
+	if len(self.FallbackHosts) > 0 {
+		self.InitFailover(client)
+	}
+	if len(self.TokenCacheDir) > 0 && len(self.RefreshToken) > 0 {
+		self.TokenRenewal = &TokenRenewalTransport{Client: self, Base: client.GetClient().Transport}
+		client.SetTransport(self.TokenRenewal)
+	}
 	self.Client = client
 
 	diags.Append(self.GetAccessToken()...)
@@ -88,24 +107,28 @@ func (self *AriaClient) CheckConfig() diag.Diagnostics {
 func (self *AriaClient) GetAccessToken() diag.Diagnostics {
 	diags := diag.Diagnostics{}
 
+	// Reuse access token from cache (if enabled) instead of requesting a new one
+	if len(self.RefreshToken) > 0 && len(self.AccessToken) == 0 {
+		if token := self.LoadCachedAccessToken(); len(token) > 0 {
+			self.AccessToken = token
+			self.Client.SetAuthToken(token)
+			if self.TokenRenewal != nil {
+				self.TokenRenewal.SetCachedToken(token)
+			}
+		}
+	}
+
 	// Refresh access token if refresh token is set and access token is empty
 	if len(self.RefreshToken) > 0 && len(self.AccessToken) == 0 {
-		self.Debug("Requesting a new API access token at %s", self.Host)
-
-		var token AccessTokenResponse
-		path := "iaas/api/login"
-		response, err := self.R(path).
-			SetHeader("Content-Type", "application/json").
-			SetBody(map[string]string{"refreshToken": self.RefreshToken}).
-			SetResult(&token).
-			Post(path)
-		err = self.HandleAPIResponse(response, err, []int{200})
+		token, err := self.RequestAccessToken()
 		if err != nil {
 			diags.AddError("Unable to retrieve a valid access token", err.Error())
 			return diags
 		}
 
-		self.AccessToken = token.Token
+		self.AccessToken = token
+		self.Client.SetAuthToken(token)
+		self.StoreCachedAccessToken(token)
 	}
 
 	if len(self.AccessToken) == 0 {
@@ -117,6 +140,24 @@ func (self *AriaClient) GetAccessToken() diag.Diagnostics {
 	return diags
 }
 
+// Request a new access token (login with the refresh token), the client is left unchanged.
+func (self AriaClient) RequestAccessToken() (string, error) {
+	self.Debug("Requesting a new API access token at %s", self.Host)
+
+	var token AccessTokenResponse
+	path := "iaas/api/login"
+	response, err := self.R(path).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"refreshToken": self.RefreshToken}).
+		SetResult(&token).
+		Post(path)
+	err = self.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return "", err
+	}
+	return token.Token, nil
+}
+
 // Return a new request insance with apiVersion header set, based on path.
 func (self AriaClient) R(path string) *resty.Request {
 	if version := self.GetVersionFromPath(path); len(version) > 0 {
@@ -236,6 +277,10 @@ func (self AriaClient) DeleteIt(
 	name := instance.String()
 	self.Debug("Deleting %s...", name)
 
+	// Track the references blocking the deletion to detect a conflict that is not converging
+	conflictSignature := ""
+	conflictStalledAttempts := 0
+
 	for attempt := 0; attempt <= conflictMaxAttempts; attempt++ {
 
 		// Delete the resource
@@ -243,16 +288,44 @@ func (self AriaClient) DeleteIt(
 		response, err := self.R(deletePath).Delete(deletePath)
 		err = self.HandleAPIResponse(response, err, []int{200, 204})
 		if err != nil {
+			if response == nil || response.StatusCode() != 409 {
+				diags.AddError(
+					"Client error",
+					fmt.Sprintf("Unable to delete %s, got error: %s", name, err))
+				return diags
+			}
+
+			conflict := ParseConflict(response.Body())
+			signature := conflict.Signature()
+			if len(signature) > 0 && signature == conflictSignature {
+				conflictStalledAttempts++
+			} else {
+				conflictStalledAttempts = 0
+			}
+			conflictSignature = signature
+			stalled := conflictStalledAttempts >= CONFLICT_STALL_MAX_ATTEMPTS
+
 			// This is potentially an error that will be solved by the deletion of other resources.
 			// We can retry the delete operation after some time to converge to desired state.
-			if attempt < conflictMaxAttempts && response != nil && response.StatusCode() == 409 {
-				time.Sleep(time.Duration(3) * time.Second) // TODO better with randomness?
+			if attempt < conflictMaxAttempts && !stalled {
+				self.Debug(
+					"Conflict deleting %s (attempt %d of %d), will retry: %s",
+					name, attempt+1, conflictMaxAttempts+1, conflict.Message)
+				time.Sleep(conflictRetryDelay) // TODO better with randomness?
 				continue
 			}
-			// Either its not a conflict error either we have made sufficient attempts...
-			diags.AddError(
-				"Client error",
-				fmt.Sprintf("Unable to delete %s, got error: %s", name, err))
+
+			// Either the conflict is stalled either we have made sufficient attempts...
+			detail := fmt.Sprintf("Unable to delete %s, got error: %s", name, err)
+			if stalled {
+				detail += fmt.Sprintf(
+					"\n\nGave up after %d attempts, the blocking references did not change.",
+					attempt+1)
+			}
+			if description := conflict.Describe(instance); len(description) > 0 {
+				detail += "\n\n" + description
+			}
+			diags.AddError("Client error", detail)
 			return diags
 		}
 
@@ -0,0 +1,125 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// HostPool keeps track of the API hosts (primary first, then fallbacks) of a multi-node appliance
+// and remembers which ones are unavailable for the remainder of the run.
+type HostPool struct {
+	lock      sync.Mutex
+	hosts     []string
+	active    int
+	unhealthy map[string]bool
+}
+
+// Returns a properly initialized HostPool (empty hosts are ignored).
+func NewHostPool(hosts ...string) *HostPool {
+	pool := &HostPool{unhealthy: make(map[string]bool)}
+	for _, host := range hosts {
+		if host = strings.TrimRight(strings.TrimSpace(host), "/"); len(host) > 0 {
+			pool.hosts = append(pool.hosts, host)
+		}
+	}
+	return pool
+}
+
+// Return the host requests should be sent to.
+func (self *HostPool) Active() string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if len(self.hosts) == 0 {
+		return ""
+	}
+	return self.hosts[self.active]
+}
+
+// Return the host (of the pool) the given URL is pointing to, or an empty string.
+func (self *HostPool) HostOf(url string) string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	for _, host := range self.hosts {
+		if url == host || strings.HasPrefix(url, host+"/") {
+			return host
+		}
+	}
+	return ""
+}
+
+// Mark the host as unhealthy and switch to the next healthy one (if any).
+// Return the newly active host and true, or the active host and false if there is no alternative.
+func (self *HostPool) MarkUnhealthy(host string) (string, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.unhealthy[host] = true
+
+	// Another request may have already failed over
+	if self.hosts[self.active] != host && !self.unhealthy[self.hosts[self.active]] {
+		return self.hosts[self.active], true
+	}
+
+	for offset := 1; offset < len(self.hosts); offset++ {
+		index := (self.active + offset) % len(self.hosts)
+		if !self.unhealthy[self.hosts[index]] {
+			self.active = index
+			return self.hosts[index], true
+		}
+	}
+	return self.hosts[self.active], false
+}
+
+// Return true if the request never reached the host (connection error) or the host is unavailable
+// (HTTP 503). Such requests can be safely sent to another node of the appliance.
+func IsHostUnavailable(response *resty.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+	return response != nil && response.StatusCode() == http.StatusServiceUnavailable
+}
+
+// Configure the HTTP client to send requests to the active host and fail over to the next one
+// on connection errors or HTTP 503.
+func (self *AriaClient) InitFailover(client *resty.Client) {
+	self.Hosts = NewHostPool(append([]string{self.Host}, self.FallbackHosts...)...)
+
+	// Relative paths are resolved against the active host (at every attempt)
+	client.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		if !strings.HasPrefix(r.URL, "http://") && !strings.HasPrefix(r.URL, "https://") {
+			r.URL = self.Hosts.Active() + "/" + strings.TrimLeft(r.URL, "/")
+		}
+		return nil
+	})
+
+	client.SetRetryCount(len(self.Hosts.hosts) - 1)
+	client.AddRetryCondition(IsHostUnavailable)
+	client.AddRetryHook(func(response *resty.Response, err error) {
+		if response == nil || response.Request == nil {
+			return
+		}
+		host := self.Hosts.HostOf(response.Request.URL)
+		if len(host) == 0 {
+			return
+		}
+		reason := "HTTP 503"
+		if err != nil {
+			reason = err.Error()
+		}
+		if next, ok := self.Hosts.MarkUnhealthy(host); ok {
+			self.Warn("Aria host %s is unavailable (%s), failing over to %s", host, reason, next)
+		} else {
+			self.Error("Aria host %s is unavailable (%s), no healthy host left", host, reason)
+		}
+	})
+
+	self.Info("Active Aria host is %s", self.Hosts.Active())
+}
@@ -0,0 +1,115 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newFailoverTestClient builds an AriaClient with fallback hosts, pre-authenticated with a fake
+// access token so Init does not attempt a token exchange.
+func newFailoverTestClient(t *testing.T, host string, fallbackHosts ...string) *AriaClient {
+	t.Helper()
+	client := &AriaClient{
+		Host:               host,
+		FallbackHosts:      fallbackHosts,
+		AccessToken:        "fake-token",
+		OKAPICallsLogLevel: "DEBUG",
+		KOAPICallsLogLevel: "WARN",
+		Context:            t.Context(),
+	}
+	if diags := client.Init(); diags.HasError() {
+		t.Fatalf("AriaClient.Init: %v", diags.Errors())
+	}
+	return client
+}
+
+func TestHostPool(t *testing.T) {
+	pool := NewHostPool("https://node1/", " ", "https://node2", "https://node3")
+	CheckEqual(t, pool.Active(), "https://node1")
+	CheckEqual(t, pool.HostOf("https://node2/vco/api/tasks"), "https://node2")
+	CheckEqual(t, pool.HostOf("https://node4/vco/api/tasks"), "")
+
+	next, ok := pool.MarkUnhealthy("https://node1")
+	CheckEqual(t, next, "https://node2")
+	CheckEqual(t, ok, true)
+
+	// A concurrent request failing on the previous host keeps the already elected one
+	next, ok = pool.MarkUnhealthy("https://node1")
+	CheckEqual(t, next, "https://node2")
+	CheckEqual(t, ok, true)
+
+	next, ok = pool.MarkUnhealthy("https://node2")
+	CheckEqual(t, next, "https://node3")
+	CheckEqual(t, ok, true)
+
+	next, ok = pool.MarkUnhealthy("https://node3")
+	CheckEqual(t, next, "https://node3")
+	CheckEqual(t, ok, false)
+}
+
+func TestAriaClientFailoverOnServiceUnavailable(t *testing.T) {
+	primaryCalls := 0
+	primary := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls++
+		writeJSONStatus(w, http.StatusServiceUnavailable, map[string]any{"message": "down"})
+	})
+	fallbackCalls := 0
+	fallback := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fallbackCalls++
+		writeJSONStatus(w, http.StatusOK, map[string]any{"id": "task-123", "state": "pending"})
+	})
+	client := newFailoverTestClient(t, primary.URL, fallback.URL)
+
+	for range 2 {
+		var raw OrchestratorTaskAPIModel
+		found, _, diags := client.ReadIt(taskModel("task-123"), &raw)
+		if diags.HasError() {
+			t.Fatalf("ReadIt: %v", diags.Errors())
+		}
+		CheckEqual(t, found, true)
+		CheckEqual(t, raw.State, "pending")
+	}
+
+	// Primary is remembered as unhealthy, second request goes straight to the fallback
+	CheckEqual(t, primaryCalls, 1)
+	CheckEqual(t, fallbackCalls, 2)
+	CheckEqual(t, client.Hosts.Active(), fallback.URL)
+}
+
+func TestAriaClientFailoverOnConnectionError(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	fallback := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSONStatus(w, http.StatusOK, map[string]any{"id": "task-123", "state": "pending"})
+	})
+	client := newFailoverTestClient(t, down.URL, fallback.URL)
+
+	var raw OrchestratorTaskAPIModel
+	found, _, diags := client.ReadIt(taskModel("task-123"), &raw)
+	if diags.HasError() {
+		t.Fatalf("ReadIt: %v", diags.Errors())
+	}
+	CheckEqual(t, found, true)
+	CheckEqual(t, client.Hosts.Active(), fallback.URL)
+}
+
+func TestAriaClientFailoverNotOnClientErrors(t *testing.T) {
+	primary := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSONStatus(w, http.StatusBadRequest, map[string]any{"message": "invalid"})
+	})
+	fallback := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to fallback: %s %s", r.Method, r.URL.Path)
+	})
+	client := newFailoverTestClient(t, primary.URL, fallback.URL)
+
+	var raw OrchestratorTaskAPIModel
+	_, diags := client.CreateIt(taskModel(""), &raw, map[string]any{"name": "x"}, 202)
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic when the API returns an unexpected status")
+	}
+	CheckEqual(t, client.Hosts.Active(), primary.URL)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cached access tokens are not reused when they expire in less than this duration.
+// This leaves enough time to the run to complete with the token.
+const TOKEN_CACHE_EXPIRY_MARGIN = 15 * time.Minute
+
+// AccessTokenCacheEntry describes the content of a token cache file.
+type AccessTokenCacheEntry struct {
+	Host      string    `json:"host"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Return the path of the file caching the access token of this client.
+// The name is derived from the host and a hash of the refresh token (never stored in clear).
+func (self AriaClient) TokenCachePath() string {
+	refreshTokenHash := sha256.Sum256([]byte(self.RefreshToken))
+	key := sha256.Sum256([]byte(fmt.Sprintf("%s\n%x", self.Host, refreshTokenHash)))
+	return filepath.Join(self.TokenCacheDir, fmt.Sprintf("%x.json", key))
+}
+
+// Return the cached access token if still valid (with some margin) else an empty string.
+func (self AriaClient) LoadCachedAccessToken() string {
+	if len(self.TokenCacheDir) == 0 {
+		return ""
+	}
+
+	path := self.TokenCachePath()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			self.Warn("Unable to read token cache %s, got error: %s", path, err)
+		}
+		return ""
+	}
+
+	var entry AccessTokenCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		self.Warn("Unable to decode token cache %s, got error: %s", path, err)
+		return ""
+	}
+
+	if entry.Host != self.Host || len(entry.Token) == 0 {
+		return ""
+	}
+
+	if time.Until(entry.ExpiresAt) < TOKEN_CACHE_EXPIRY_MARGIN {
+		self.Debug("Cached API access token for %s is expired or expiring soon", self.Host)
+		return ""
+	}
+
+	self.Debug("Reusing cached API access token for %s (expires at %s)", self.Host, entry.ExpiresAt)
+	return entry.Token
+}
+
+// Store the access token into the cache (readable only by current user).
+// Failing to do so is not an error, the token is simply not cached.
+func (self AriaClient) StoreCachedAccessToken(token string) {
+	if len(self.TokenCacheDir) == 0 {
+		return
+	}
+
+	expiresAt, err := GetAccessTokenExpiry(token)
+	if err != nil {
+		self.Warn("Unable to cache API access token, got error: %s", err)
+		return
+	}
+
+	data, err := json.Marshal(AccessTokenCacheEntry{
+		Host:      self.Host,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		self.Warn("Unable to encode token cache, got error: %s", err)
+		return
+	}
+
+	if err := os.MkdirAll(self.TokenCacheDir, 0o700); err != nil {
+		self.Warn("Unable to create token cache directory %s, got error: %s", self.TokenCacheDir, err)
+		return
+	}
+
+	// Write to a temporary file (created with 0600) then rename it to make the update atomic,
+	// concurrent runs (or provider aliases) will never read a partially written file.
+	path := self.TokenCachePath()
+	file, err := os.CreateTemp(self.TokenCacheDir, ".token-*")
+	if err != nil {
+		self.Warn("Unable to create token cache %s, got error: %s", path, err)
+		return
+	}
+	defer os.Remove(file.Name()) //nolint:errcheck // Already renamed on success
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		self.Warn("Unable to write token cache %s, got error: %s", path, err)
+		return
+	}
+
+	self.Debug("Cached API access token for %s (expires at %s)", self.Host, expiresAt)
+}
+
+// Remove the cached access token (e.g. revoked).
+// Failing to do so is not an error, the token is simply kept in cache.
+func (self AriaClient) DropCachedAccessToken() {
+	if len(self.TokenCacheDir) == 0 {
+		return
+	}
+
+	path := self.TokenCachePath()
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		self.Warn("Unable to remove token cache %s, got error: %s", path, err)
+		return
+	}
+
+	self.Debug("Removed cached API access token for %s", self.Host)
+}
+
+// TokenRenewalTransport renews a cached access token rejected by the API (HTTP 401, e.g. revoked
+// by a logout) and sends the request again (once) with the new access token.
+type TokenRenewalTransport struct {
+	Client *AriaClient
+	Base   http.RoundTripper
+
+	lock sync.Mutex
+
+	// Access token loaded from the cache (empty once renewed) and the one it was renewed from
+	cachedToken  string
+	renewedToken string
+}
+
+func (self *TokenRenewalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	response, err := self.Base.RoundTrip(req)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return response, err // Unable to send the body again
+	}
+
+	rejected := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	token, ok := self.Renew(rejected)
+	if !ok {
+		return response, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return response, nil
+		}
+	}
+	retry.Header.Set("Authorization", "Bearer "+token)
+	response.Body.Close() //nolint:errcheck // Replaced by the response to the retry
+	return self.Base.RoundTrip(retry)
+}
+
+// Set the access token loaded from the cache (renewed if rejected).
+func (self *TokenRenewalTransport) SetCachedToken(token string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.cachedToken = token
+}
+
+// Renew the access token if the rejected one was loaded from the cache.
+// Return the access token to send the request again with and true, or false if not applicable.
+func (self *TokenRenewalTransport) Renew(rejected string) (string, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// Already renewed by a concurrent request
+	if len(self.renewedToken) > 0 && rejected == self.renewedToken {
+		return self.Client.AccessToken, true
+	}
+
+	if len(self.cachedToken) == 0 || rejected != self.cachedToken {
+		return "", false
+	}
+
+	// Forget the cached token first (the login request must not be renewed)
+	self.Client.Warn(
+		"Cached API access token for %s was rejected, requesting a new one", self.Client.Host)
+	self.cachedToken = ""
+	self.renewedToken = rejected
+	self.Client.DropCachedAccessToken()
+
+	token, err := self.Client.RequestAccessToken()
+	if err != nil {
+		self.Client.Warn("Unable to renew the API access token, got error: %s", err)
+		return "", false
+	}
+
+	self.Client.AccessToken = token
+	self.Client.Client.SetAuthToken(token)
+	self.Client.StoreCachedAccessToken(token)
+	return token, true
+}
+
+// Return the expiration time of a JWT access token (exp claim).
+func GetAccessTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to decode access token payload: %w", err)
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("unable to decode access token claims: %w", err)
+	}
+
+	if claims.Exp == 0 {
+		return time.Time{}, errors.New("access token has no expiration time")
+	}
+
+	return time.Unix(claims.Exp, 0).UTC(), nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+// fakeJWT returns an unsigned JWT expiring at the given time (signature is never verified).
+func fakeJWT(expiresAt time.Time) string {
+	payload := fmt.Sprintf(`{"sub":"test","exp":%d}`, expiresAt.Unix())
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+}
+
+// newLoginAPI starts a fake API answering logins with token and counting them.
+func newLoginAPI(t *testing.T, token string, logins *int) string {
+	t.Helper()
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/iaas/api/login" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		*logins++
+		writeJSONStatus(w, http.StatusOK, map[string]any{"tokenType": "Bearer", "token": token})
+	})
+	return server.URL
+}
+
+// newCachingClient builds and initializes an AriaClient using the refresh token and the cache.
+func newCachingClient(t *testing.T, host string, refreshToken string, cacheDir string) *AriaClient {
+	t.Helper()
+	client := &AriaClient{
+		Host:               host,
+		RefreshToken:       refreshToken,
+		TokenCacheDir:      cacheDir,
+		OKAPICallsLogLevel: "DEBUG",
+		KOAPICallsLogLevel: "WARN",
+		Context:            t.Context(),
+	}
+	if diags := client.Init(); diags.HasError() {
+		t.Fatalf("AriaClient.Init: %v", diags.Errors())
+	}
+	return client
+}
+
+func TestGetAccessTokenExpiry(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	actual, err := GetAccessTokenExpiry(fakeJWT(expiresAt))
+	if err != nil {
+		t.Fatalf("GetAccessTokenExpiry: %v", err)
+	}
+	if !actual.Equal(expiresAt) {
+		t.Errorf("expiry = %s, want %s", actual, expiresAt)
+	}
+
+	for _, token := range []string{"", "opaque-token", "a.!!!.c", "a.e30.c"} {
+		if _, err := GetAccessTokenExpiry(token); err == nil {
+			t.Errorf("GetAccessTokenExpiry(%q): expected an error", token)
+		}
+	}
+}
+
+func TestAriaClientTokenCacheReused(t *testing.T) {
+	token := fakeJWT(time.Now().Add(8 * time.Hour))
+	logins := 0
+	host := newLoginAPI(t, token, &logins)
+	cacheDir := t.TempDir()
+
+	first := newCachingClient(t, host, "refresh-token", cacheDir)
+	second := newCachingClient(t, host, "refresh-token", cacheDir)
+
+	CheckEqual(t, logins, 1)
+	CheckEqual(t, first.AccessToken, token)
+	CheckEqual(t, second.AccessToken, token)
+
+	info, err := os.Stat(first.TokenCachePath())
+	if err != nil {
+		t.Fatalf("token cache not written: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("token cache permissions = %o, want 600", info.Mode().Perm())
+	}
+}
+
+func TestAriaClientTokenCacheKeyedByRefreshToken(t *testing.T) {
+	logins := 0
+	host := newLoginAPI(t, fakeJWT(time.Now().Add(8*time.Hour)), &logins)
+	cacheDir := t.TempDir()
+
+	first := newCachingClient(t, host, "refresh-token-1", cacheDir)
+	second := newCachingClient(t, host, "refresh-token-2", cacheDir)
+
+	CheckEqual(t, logins, 2)
+	if first.TokenCachePath() == second.TokenCachePath() {
+		t.Error("token cache path must differ between refresh tokens")
+	}
+}
+
+func TestAriaClientTokenCacheExpiringSoon(t *testing.T) {
+	logins := 0
+	host := newLoginAPI(t, fakeJWT(time.Now().Add(5*time.Minute)), &logins)
+	cacheDir := t.TempDir()
+
+	newCachingClient(t, host, "refresh-token", cacheDir)
+	newCachingClient(t, host, "refresh-token", cacheDir)
+
+	CheckEqual(t, logins, 2)
+}
+
+func TestAriaClientTokenCacheDisabled(t *testing.T) {
+	logins := 0
+	host := newLoginAPI(t, fakeJWT(time.Now().Add(8*time.Hour)), &logins)
+
+	client := newCachingClient(t, host, "refresh-token", "")
+	newCachingClient(t, host, "refresh-token", "")
+
+	CheckEqual(t, logins, 2)
+	CheckEqual(t, client.LoadCachedAccessToken(), "")
+}
+
+func TestAriaClientTokenCacheRevoked(t *testing.T) {
+	revoked := fakeJWT(time.Now().Add(8 * time.Hour))
+	renewed := fakeJWT(time.Now().Add(9 * time.Hour))
+	logins := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iaas/api/login" {
+			logins++
+			writeJSONStatus(w, http.StatusOK, map[string]any{"tokenType": "Bearer", "token": renewed})
+		} else if r.Header.Get("Authorization") != "Bearer "+renewed {
+			writeJSONStatus(w, http.StatusUnauthorized, map[string]any{"message": "Unauthorized"})
+		} else {
+			writeJSONStatus(w, http.StatusOK, map[string]any{"name": "foo"})
+		}
+	})
+	cacheDir := t.TempDir()
+
+	// Cache a token that was revoked since (e.g. by a logout)
+	client := &AriaClient{
+		Host:          server.URL,
+		RefreshToken:  "refresh-token",
+		TokenCacheDir: cacheDir,
+		Context:       t.Context(),
+	}
+	client.StoreCachedAccessToken(revoked)
+	client = newCachingClient(t, server.URL, "refresh-token", cacheDir)
+	CheckEqual(t, logins, 0)
+	CheckEqual(t, client.AccessToken, revoked)
+
+	// Renewed once, the request is sent again with the new token
+	path := "iaas/api/projects"
+	for range 2 {
+		response, err := client.R(path).SetBody(map[string]string{"name": "foo"}).Post(path)
+		err = client.HandleAPIResponse(response, err, []int{200})
+		CheckEqual(t, err, nil)
+	}
+	CheckEqual(t, logins, 1)
+	CheckEqual(t, client.AccessToken, renewed)
+	CheckEqual(t, client.LoadCachedAccessToken(), renewed)
+}
+
+func TestAriaClientTokenRejected(t *testing.T) {
+	logins := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iaas/api/login" {
+			logins++
+			writeJSONStatus(w, http.StatusOK, map[string]any{
+				"tokenType": "Bearer",
+				"token":     fakeJWT(time.Now().Add(8 * time.Hour)),
+			})
+		} else {
+			writeJSONStatus(w, http.StatusUnauthorized, map[string]any{"message": "Unauthorized"})
+		}
+	})
+
+	// Not loaded from the cache, the token is not renewed
+	client := newCachingClient(t, server.URL, "refresh-token", t.TempDir())
+	path := "iaas/api/projects"
+	response, err := client.R(path).Get(path)
+	err = client.HandleAPIResponse(response, err, []int{200})
+	CheckEqual(t, err != nil, true)
+	CheckEqual(t, logins, 1)
+}
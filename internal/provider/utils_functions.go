@@ -0,0 +1,85 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Convert a Terraform value (e.g. a dynamic function argument) to raw value.
+// Objects and maps are converted to map[string]any, lists, sets and tuples to []any.
+// Numbers are converted to int64 when they are integers, float64 otherwise.
+func AttrValueToAny(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, errors.New("value is unknown")
+	}
+
+	switch value := value.(type) {
+	case basetypes.DynamicValue:
+		return AttrValueToAny(value.UnderlyingValue())
+	case basetypes.StringValue:
+		return value.ValueString(), nil
+	case basetypes.BoolValue:
+		return value.ValueBool(), nil
+	case basetypes.Int64Value:
+		return value.ValueInt64(), nil
+	case basetypes.Int32Value:
+		return int64(value.ValueInt32()), nil
+	case basetypes.Float64Value:
+		return value.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		number := value.ValueBigFloat()
+		if number.IsInt() {
+			if integer, accuracy := number.Int64(); accuracy == big.Exact {
+				return integer, nil
+			}
+		}
+		float, _ := number.Float64()
+		return float, nil
+	case basetypes.ListValue:
+		return attrValuesToAny(value.Elements())
+	case basetypes.SetValue:
+		return attrValuesToAny(value.Elements())
+	case basetypes.TupleValue:
+		return attrValuesToAny(value.Elements())
+	case basetypes.MapValue:
+		return attrValuesMapToAny(value.Elements())
+	case basetypes.ObjectValue:
+		return attrValuesMapToAny(value.Attributes())
+	}
+
+	return nil, fmt.Errorf("unsupported value of type %s", value.Type(nil))
+}
+
+func attrValuesToAny(values []attr.Value) ([]any, error) {
+	items := make([]any, 0, len(values))
+	for index, value := range values {
+		item, err := AttrValueToAny(value)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", index, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func attrValuesMapToAny(values map[string]attr.Value) (map[string]any, error) {
+	items := make(map[string]any, len(values))
+	for key, value := range values {
+		item, err := AttrValueToAny(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		items[key] = item
+	}
+	return items, nil
+}
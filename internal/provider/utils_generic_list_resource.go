@@ -0,0 +1,478 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Format of the responses of the APIs used to list resources.
+type ListFormat int
+
+const (
+	LIST_FORMAT_CONTENT ListFormat = iota // Paginated content (iaas, catalog, abx, ...)
+	LIST_FORMAT_VRO                       // vRO links with attributes
+)
+
+// Number of items retrieved per page (paginated content).
+const LIST_PAGE_SIZE = 500
+
+// ListFilter is an optional filter of a list resource, matching a field of the listed items.
+type ListFilter struct {
+	Attribute   string // Attribute of the list block configuration
+	Description string
+	Field       string // Field of the listed items (dot separated path, exact match)
+	QueryParam  string // Query parameter to filter server-side (optional)
+}
+
+// GenericListResourceConfig configures a generic list resource.
+type GenericListResourceConfig struct {
+	TypeName     string
+	ListPath     string
+	Format       ListFormat
+	DisplayField string // Defaults to "name"
+	Filters      []ListFilter
+	// Identity attributes -> field of the listed items (defaults to id -> id).
+	IdentityFields map[string]string
+	// Fields making the natural key of the listed items, to import them by key instead of identifier.
+	ImportKeyFields    []string
+	ImportKeySeparator string // Defaults to "/"
+	// Fields making an alternative natural key, tried if no item is matching the natural key
+	// (e.g. projectName instead of projectId, resolved from the projects).
+	ImportKeyAlternativeFields []string
+	// Type of the categories of the listed items, to resolve the "categoryPath" field (vRO).
+	CategoryType string
+	// Managed resource, to read the listed items when the resource is requested (import + read).
+	NewResource func() resource.Resource
+}
+
+func (c GenericListResourceConfig) getDisplayField() string {
+	if c.DisplayField == "" {
+		return "name"
+	}
+	return c.DisplayField
+}
+
+func (c GenericListResourceConfig) getIdentityFields() map[string]string {
+	if len(c.IdentityFields) == 0 {
+		return map[string]string{"id": "id"}
+	}
+	return c.IdentityFields
+}
+
+func (c GenericListResourceConfig) getImportKeySeparator() string {
+	if c.ImportKeySeparator == "" {
+		return "/"
+	}
+	return c.ImportKeySeparator
+}
+
+// Return the natural key of the item (empty if the listed items have no natural key).
+func (c GenericListResourceConfig) ImportKey(item listResourceItem) string {
+	return c.importKey(item, c.ImportKeyFields)
+}
+
+// Return the key of the item made of the given fields (empty if there are no fields).
+func (c GenericListResourceConfig) importKey(item listResourceItem, fields []string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		values = append(values, item.Value(field))
+	}
+	return strings.Join(values, c.getImportKeySeparator())
+}
+
+// listResourceItem is a listed item, the decoded JSON object of the list API.
+type listResourceItem map[string]any
+
+// Return the value(s) of the field (dot separated path), lists are flattened.
+func (self listResourceItem) Values(field string) []string {
+	var value any = map[string]any(self)
+	for _, key := range strings.Split(field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case bool:
+		return []string{strconv.FormatBool(value)}
+	case []any:
+		values := []string{}
+		for _, element := range value {
+			if element, ok := element.(string); ok {
+				values = append(values, element)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func (self listResourceItem) Value(field string) string {
+	values := self.Values(field)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Return true if item's name starts with prefix and its fields are matching the filters.
+func (self listResourceItem) Matches(namePrefix string, filters map[string]string) bool {
+	if !strings.HasPrefix(self.Value("name"), namePrefix) {
+		return false
+	}
+	for field, expected := range filters {
+		if !slices.Contains(self.Values(field), expected) {
+			return false
+		}
+	}
+	return true
+}
+
+// --- GenericListResource lists the instances of a managed resource ---
+
+type GenericListResource struct {
+	client *AriaClient
+	config GenericListResourceConfig
+}
+
+func (self *GenericListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + self.config.TypeName
+}
+
+func (self *GenericListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	attributes := map[string]listschema.Attribute{
+		"name_prefix": listschema.StringAttribute{
+			MarkdownDescription: "List only the resources whose name starts with this prefix",
+			Optional:            true,
+		},
+	}
+	for _, filter := range self.config.Filters {
+		attributes[filter.Attribute] = listschema.StringAttribute{
+			MarkdownDescription: filter.Description,
+			Optional:            true,
+		}
+	}
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"List the existing `aria%s` resources (identities usable in `import` blocks)",
+			self.config.TypeName),
+		Attributes: attributes,
+	}
+}
+
+func (self *GenericListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *GenericListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	// Read the filters from the list block configuration
+	var namePrefix types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("name_prefix"), &namePrefix)
+	filters := map[string]string{}
+	queryParams := map[string]string{}
+	for _, filter := range self.config.Filters {
+		var value types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(filter.Attribute), &value)...)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		filters[filter.Field] = value.ValueString()
+		if len(filter.QueryParam) > 0 {
+			queryParams[filter.QueryParam] = value.ValueString()
+		}
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := self.ListItems(queryParams)
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list aria%s resources, got error: %s", self.config.TypeName, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, item := range items {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			if !item.Matches(namePrefix.ValueString(), filters) {
+				continue
+			}
+			count++
+			if !push(self.NewListResult(ctx, req, item)) {
+				return
+			}
+		}
+	}
+}
+
+// Convert the listed item to a list result (identity and, if requested, the whole resource).
+func (self *GenericListResource) NewListResult(
+	ctx context.Context,
+	req list.ListRequest,
+	item listResourceItem,
+) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.Value(self.config.getDisplayField())
+	for attribute, field := range self.config.getIdentityFields() {
+		result.Diagnostics.Append(
+			result.Identity.SetAttribute(ctx, path.Root(attribute), item.Value(field))...)
+	}
+	if req.IncludeResource && !result.Diagnostics.HasError() {
+		resourceValue, diags := self.ReadResource(ctx, req, result.Identity)
+		result.Diagnostics.Append(diags...)
+		if resourceValue != nil {
+			result.Resource = resourceValue
+		}
+	}
+	return result
+}
+
+// Read the resource identified by identity the same way as Terraform imports it
+// (import by identity then read), all the attributes of the resource are set.
+func (self *GenericListResource) ReadResource(
+	ctx context.Context,
+	req list.ListRequest,
+	identity *tfsdk.ResourceIdentity,
+) (*tfsdk.Resource, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	var managed resource.Resource
+	if self.config.NewResource != nil {
+		managed = self.config.NewResource()
+	}
+	importer, ok := managed.(resource.ResourceWithImportState)
+	if !ok {
+		diags.AddError(
+			"Provider error",
+			fmt.Sprintf("Unable to read aria%s resources, import is not supported", self.config.TypeName))
+		return nil, diags
+	}
+	if configurable, ok := managed.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		configurable.Configure(
+			ctx, resource.ConfigureRequest{ProviderData: self.client}, &configureResp)
+		diags.Append(configureResp.Diagnostics...)
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: req.ResourceSchema,
+			Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+		},
+		Identity: identity,
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, &importResp)
+	diags.Append(importResp.Diagnostics...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: identity}
+	managed.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: identity}, &readResp)
+	diags.Append(readResp.Diagnostics...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if readResp.State.Raw.IsNull() {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read aria%s resource, it does not exist anymore", self.config.TypeName))
+		return nil, diags
+	}
+	return &tfsdk.Resource{Schema: readResp.State.Schema, Raw: readResp.State.Raw}, diags
+}
+
+// Retrieve all items from the list API.
+func (self *GenericListResource) ListItems(queryParams map[string]string) ([]listResourceItem, error) {
+	listPath := self.config.ListPath
+	items := []listResourceItem{}
+
+	if self.config.Format == LIST_FORMAT_VRO {
+		var raw vROLinksAPIModel
+		response, err := self.client.R(listPath).
+			SetQueryParams(queryParams).
+			SetResult(&raw).
+			Get(listPath)
+		if err := self.client.HandleAPIResponse(response, err, []int{200}); err != nil {
+			return nil, err
+		}
+		for _, link := range raw.Link {
+			item := listResourceItem{"id": idFromHref(link.Href)}
+			for _, attribute := range link.Attributes {
+				item[attribute.Name] = attribute.Value
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	for page := 0; ; page++ {
+		var raw listPageAPIModel
+		response, err := self.client.R(listPath).
+			SetQueryParams(queryParams).
+			SetQueryParam("page", strconv.Itoa(page)).
+			SetQueryParam("size", strconv.Itoa(LIST_PAGE_SIZE)).
+			SetResult(&raw).
+			Get(listPath)
+		if err := self.client.HandleAPIResponse(response, err, []int{200}); err != nil {
+			return nil, err
+		}
+		for _, item := range raw.Content {
+			items = append(items, listResourceItem(item))
+		}
+		if page+1 >= raw.TotalPages || len(raw.Content) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// Return true if the item with the given identifier exists (retrieved without listing the items).
+func (self *GenericListResource) Exists(id string) bool {
+	itemPath := self.config.ListPath + "/" + url.PathEscape(id)
+	response, err := self.client.R(itemPath).Get(itemPath)
+	return self.client.HandleAPIResponse(response, err, []int{200}) == nil
+}
+
+// Resolve the natural key to the matching item (nil if no item is matching, error if ambiguous).
+func (self *GenericListResource) ResolveImportKey(key string) (listResourceItem, error) {
+	if len(self.config.ImportKeyFields) == 0 {
+		return nil, nil
+	}
+	items, err := self.ListItems(map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	if len(self.config.CategoryType) > 0 {
+		if err := self.SetCategoryPaths(items); err != nil {
+			return nil, err
+		}
+	}
+	match, err := self.matchImportKey(items, key, self.config.ImportKeyFields)
+	if err != nil || match != nil || len(self.config.ImportKeyAlternativeFields) == 0 {
+		return match, err
+	}
+	if slices.Contains(self.config.ImportKeyAlternativeFields, "projectName") {
+		if err := self.SetProjectNames(items); err != nil {
+			return nil, err
+		}
+	}
+	return self.matchImportKey(items, key, self.config.ImportKeyAlternativeFields)
+}
+
+// Return the item whose key (made of fields) is matching (nil if none, error if ambiguous).
+func (self *GenericListResource) matchImportKey(
+	items []listResourceItem,
+	key string,
+	fields []string,
+) (listResourceItem, error) {
+	var match listResourceItem
+	for _, item := range items {
+		if self.config.importKey(item, fields) != key {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf(
+				"multiple aria%s resources are matching %q, import by identifier instead",
+				self.config.TypeName, key)
+		}
+		match = item
+	}
+	return match, nil
+}
+
+// Set the "projectName" field of the items (from their "projectId").
+func (self *GenericListResource) SetProjectNames(items []listResourceItem) error {
+	projects := GenericListResource{
+		client: self.client,
+		config: GenericListResourceConfig{
+			ListPath: ProjectModel{}.ListPath(),
+			Format:   LIST_FORMAT_CONTENT,
+		},
+	}
+	projectItems, err := projects.ListItems(map[string]string{})
+	if err != nil {
+		return err
+	}
+	names := map[string]string{}
+	for _, project := range projectItems {
+		names[project.Value("id")] = project.Value("name")
+	}
+	for _, item := range items {
+		item["projectName"] = names[item.Value("projectId")]
+	}
+	return nil
+}
+
+// Set the "categoryPath" field of the items (falls back to the name of their category).
+func (self *GenericListResource) SetCategoryPaths(items []listResourceItem) error {
+	categories := GenericListResource{
+		client: self.client,
+		config: GenericListResourceConfig{ListPath: "vco/api/categories", Format: LIST_FORMAT_VRO},
+	}
+	categoryItems, err := categories.ListItems(map[string]string{"categoryType": self.config.CategoryType})
+	if err != nil {
+		return err
+	}
+	paths := map[string]string{}
+	for _, category := range categoryItems {
+		paths[category.Value("id")] = category.Value("path")
+	}
+	for _, item := range items {
+		path := paths[item.Value("categoryId")]
+		if len(path) == 0 {
+			path = item.Value("categoryName")
+		}
+		item["categoryPath"] = path
+	}
+	return nil
+}
+
+// listPageAPIModel is a page of a paginated list response.
+type listPageAPIModel struct {
+	Content    []map[string]any `json:"content"`
+	TotalPages int              `json:"totalPages"`
+}
@@ -0,0 +1,372 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Build the list request (configuration of the list block is given as filters, others are null).
+func newListRequest(
+	t *testing.T,
+	listResource *GenericListResource,
+	resourceSchema resource.SchemaResponse,
+	identitySchema resource.IdentitySchemaResponse,
+	filters map[string]string,
+	limit int64,
+) list.ListRequest {
+	t.Helper()
+	ctx := t.Context()
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	values := map[string]tftypes.Value{}
+	for name := range schemaResp.Schema.Attributes {
+		if value, ok := filters[name]; ok {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		} else {
+			values[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+	}
+
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), values),
+			Schema: schemaResp.Schema,
+		},
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+}
+
+// Run the list resource and return the results (failing on diagnostics).
+func runListResource(t *testing.T, listResource *GenericListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+	stream := list.ListResultsStream{}
+	listResource.List(t.Context(), req, &stream)
+	results := []list.ListResult{}
+	for result := range stream.Results {
+		CheckDiagnostics(t, result.Diagnostics, "", "")
+		results = append(results, result)
+	}
+	return results
+}
+
+func identityAttribute(t *testing.T, result list.ListResult, name string) string {
+	t.Helper()
+	var value types.String
+	CheckDiagnostics(t, result.Identity.GetAttribute(t.Context(), path.Root(name), &value), "", "")
+	return value.ValueString()
+}
+
+func TestListResourceItemValues(t *testing.T) {
+	item := listResourceItem{
+		"name":        "foo",
+		"enabled":     true,
+		"constraints": map[string]any{"projectId": []any{"p1", "p2"}},
+	}
+	CheckEqual(t, item.Value("name"), "foo")
+	CheckEqual(t, item.Value("enabled"), "true")
+	CheckEqual(t, item.Value("missing"), "")
+	CheckDeepEqual(t, item.Values("constraints.projectId"), []string{"p1", "p2"})
+	CheckEqual(t, item.Matches("fo", map[string]string{"constraints.projectId": "p2"}), true)
+	CheckEqual(t, item.Matches("fo", map[string]string{"constraints.projectId": "p3"}), false)
+	CheckEqual(t, item.Matches("bar", nil), false)
+}
+
+func TestGenericListResourceContent(t *testing.T) {
+	pages := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		CheckEqual(t, r.URL.Query().Get("projectId"), "project-1")
+		// Resources are read the same way as they are imported
+		if id, found := strings.CutPrefix(r.URL.Path, "/abx/api/resources/actions/"); found {
+			writeJSONStatus(w, http.StatusOK, ABXActionAPIModel{
+				Id:          id,
+				Name:        "TEST_" + id,
+				RuntimeName: "python",
+				Entrypoint:  "handler",
+				ProjectId:   "project-1",
+			})
+			return
+		}
+		if r.URL.Path != "/abx/api/resources/actions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		pages++
+		content := []map[string]any{
+			{"id": "a1", "name": "TEST_one", "projectId": "project-1"},
+			{"id": "a2", "name": "other", "projectId": "project-1"},
+		}
+		if r.URL.Query().Get("page") == "1" {
+			content = []map[string]any{{"id": "a3", "name": "TEST_three", "projectId": "project-1"}}
+		}
+		writeJSONStatus(w, http.StatusOK, map[string]any{"content": content, "totalPages": 2})
+	})
+
+	listResource, ok := NewABXActionListResource().(*GenericListResource)
+	if !ok {
+		t.Fatalf("list resource is not a generic list resource")
+	}
+	listResource.client = newTestClient(t, server.URL)
+
+	abxResource, ok := NewABXActionResource().(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatalf("resource does not support identity")
+	}
+	resourceSchema := resource.SchemaResponse{}
+	abxResource.Schema(t.Context(), resource.SchemaRequest{}, &resourceSchema)
+	identitySchema := resource.IdentitySchemaResponse{}
+	abxResource.IdentitySchema(t.Context(), resource.IdentitySchemaRequest{}, &identitySchema)
+
+	filters := map[string]string{"name_prefix": "TEST_", "project_id": "project-1"}
+	req := newListRequest(t, listResource, resourceSchema, identitySchema, filters, 0)
+	results := runListResource(t, listResource, req)
+	CheckEqual(t, pages, 2)
+	CheckEqual(t, len(results), 2)
+	if len(results) == 2 {
+		CheckEqual(t, results[0].DisplayName, "TEST_one")
+		CheckEqual(t, identityAttribute(t, results[0], "id"), "a1")
+		CheckEqual(t, identityAttribute(t, results[0], "project_id"), "project-1")
+		CheckEqual(t, identityAttribute(t, results[1], "id"), "a3")
+
+		// The whole resource is set (including required attributes)
+		var name, runtimeName types.String
+		diags := results[1].Resource.GetAttribute(t.Context(), path.Root("name"), &name)
+		CheckDiagnostics(t, diags, "", "")
+		CheckEqual(t, name.ValueString(), "TEST_a3")
+		diags = results[1].Resource.GetAttribute(t.Context(), path.Root("runtime_name"), &runtimeName)
+		CheckDiagnostics(t, diags, "", "")
+		CheckEqual(t, runtimeName.ValueString(), "python")
+	}
+
+	// Results are limited
+	req = newListRequest(t, listResource, resourceSchema, identitySchema, filters, 1)
+	CheckEqual(t, len(runListResource(t, listResource, req)), 1)
+}
+
+func TestGenericListResourceVRO(t *testing.T) {
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		CheckEqual(t, r.URL.Path, "/vco/api/actions")
+		writeJSONStatus(w, http.StatusOK, vROLinksAPIModel{
+			Link: []vROLinkAPIModel{
+				{
+					Href: "https://aria.example.com/vco/api/actions/id-1/",
+					Attributes: []vROLinkAttributeAPIModel{
+						{Name: "name", Value: "getFoo"},
+						{Name: "module", Value: "com.example.foo"},
+						{Name: "fqn", Value: "com.example.foo/getFoo"},
+					},
+				},
+				{
+					Href: "https://aria.example.com/vco/api/actions/id-2/",
+					Attributes: []vROLinkAttributeAPIModel{
+						{Name: "name", Value: "getBar"},
+						{Name: "module", Value: "com.example.bar"},
+						{Name: "fqn", Value: "com.example.bar/getBar"},
+					},
+				},
+			},
+			Total: 2,
+		})
+	})
+
+	listResource, ok := NewOrchestratorActionListResource().(*GenericListResource)
+	if !ok {
+		t.Fatalf("list resource is not a generic list resource")
+	}
+	listResource.client = newTestClient(t, server.URL)
+
+	actionResource, ok := NewOrchestratorActionResource().(*OrchestratorActionResource)
+	if !ok {
+		t.Fatalf("resource is not an orchestrator action resource")
+	}
+	resourceSchema := resource.SchemaResponse{}
+	actionResource.Schema(t.Context(), resource.SchemaRequest{}, &resourceSchema)
+	identitySchema := resource.IdentitySchemaResponse{}
+	actionResource.IdentitySchema(t.Context(), resource.IdentitySchemaRequest{}, &identitySchema)
+
+	filters := map[string]string{"module": "com.example.bar"}
+	req := newListRequest(t, listResource, resourceSchema, identitySchema, filters, 0)
+	req.IncludeResource = false
+	results := runListResource(t, listResource, req)
+	CheckEqual(t, len(results), 1)
+	if len(results) == 1 {
+		CheckEqual(t, results[0].DisplayName, "com.example.bar/getBar")
+		CheckEqual(t, identityAttribute(t, results[0], "id"), "id-2")
+	}
+}
+
+func TestGenericListResourceResolveImportKey(t *testing.T) {
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vco/api/categories":
+			CheckEqual(t, r.URL.Query().Get("categoryType"), "WorkflowCategory")
+			writeJSONStatus(w, http.StatusOK, vROLinksAPIModel{
+				Link: []vROLinkAPIModel{
+					{
+						Href: "https://aria.example.com/vco/api/categories/cat-1/",
+						Attributes: []vROLinkAttributeAPIModel{
+							{Name: "name", Value: "Example"},
+							{Name: "path", Value: "Library/Example"},
+						},
+					},
+				},
+			})
+		case "/vco/api/workflows":
+			links := []vROLinkAPIModel{}
+			for _, workflow := range [][]string{
+				{"wf-1", "Do Something", "cat-1", "Example"},
+				{"wf-2", "Do Something", "cat-2", "Other"},
+				{"wf-3", "Duplicated", "cat-2", "Other"},
+				{"wf-4", "Duplicated", "cat-2", "Other"},
+			} {
+				links = append(links, vROLinkAPIModel{
+					Href: "https://aria.example.com/vco/api/workflows/" + workflow[0] + "/",
+					Attributes: []vROLinkAttributeAPIModel{
+						{Name: "name", Value: workflow[1]},
+						{Name: "categoryId", Value: workflow[2]},
+						{Name: "categoryName", Value: workflow[3]},
+					},
+				})
+			}
+			writeJSONStatus(w, http.StatusOK, vROLinksAPIModel{Link: links})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	listResource := GenericListResource{
+		client: newTestClient(t, server.URL),
+		config: OrchestratorWorkflowListResourceConfig(),
+	}
+
+	item, err := listResource.ResolveImportKey("Library/Example/Do Something")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item.Value("id"), "wf-1")
+
+	// Path falls back to the name of the category
+	item, err = listResource.ResolveImportKey("Other/Do Something")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item.Value("id"), "wf-2")
+
+	// Not a natural key, probably an identifier
+	item, err = listResource.ResolveImportKey("wf-1")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item == nil, true)
+
+	// Ambiguous
+	_, err = listResource.ResolveImportKey("Other/Duplicated")
+	CheckEqual(t, err != nil, true)
+}
+
+func TestImportStateByIdOrNaturalKey(t *testing.T) {
+	ctx := t.Context()
+	listed := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vco/api/workflows/wf-1":
+			writeJSONStatus(w, http.StatusOK, map[string]any{"id": "wf-1"})
+		case "/vco/api/workflows":
+			listed++
+			writeJSONStatus(w, http.StatusOK, vROLinksAPIModel{
+				Link: []vROLinkAPIModel{
+					{
+						Href: "https://aria.example.com/vco/api/workflows/wf-1/",
+						Attributes: []vROLinkAttributeAPIModel{
+							{Name: "name", Value: "Do Something"},
+							{Name: "categoryId", Value: "cat-1"},
+							{Name: "categoryName", Value: "Example"},
+						},
+					},
+				},
+			})
+		case "/vco/api/categories":
+			writeJSONStatus(w, http.StatusOK, vROLinksAPIModel{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := newTestClient(t, server.URL)
+
+	importId := func(id string) string {
+		t.Helper()
+		schema := OrchestratorWorkflowSchema()
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schema,
+				Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		ImportStateByIdOrNaturalKey(
+			ctx, client, OrchestratorWorkflowListResourceConfig(),
+			resource.ImportStateRequest{ID: id}, &resp)
+		CheckDiagnostics(t, resp.Diagnostics, "", "")
+		var value types.String
+		CheckDiagnostics(t, resp.State.GetAttribute(ctx, path.Root("id"), &value), "", "")
+		return value.ValueString()
+	}
+
+	// Existing identifier, resources are not listed
+	CheckEqual(t, importId("wf-1"), "wf-1")
+	CheckEqual(t, listed, 0)
+
+	// Natural key, resolved by listing the resources
+	CheckEqual(t, importId("Example/Do Something"), "wf-1")
+	CheckEqual(t, listed, 1)
+}
+
+func TestGenericListResourceResolveImportKeyProjectName(t *testing.T) {
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/project-service/api/projects":
+			writeJSONStatus(w, http.StatusOK, map[string]any{
+				"content": []map[string]any{
+					{"id": "project-1", "name": "Development"},
+					{"id": "project-2", "name": "Production"},
+				},
+				"totalPages": 1,
+			})
+		case "/abx/api/resources/actions":
+			writeJSONStatus(w, http.StatusOK, map[string]any{
+				"content": []map[string]any{
+					{"id": "a1", "name": "setHostname", "projectId": "project-1"},
+					{"id": "a2", "name": "setHostname", "projectId": "project-2"},
+				},
+				"totalPages": 1,
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	listResource := GenericListResource{
+		client: newTestClient(t, server.URL),
+		config: ABXActionListResourceConfig(),
+	}
+
+	// By project identifier
+	item, err := listResource.ResolveImportKey("project-2:setHostname")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item.Value("id"), "a2")
+
+	// By project name
+	item, err = listResource.ResolveImportKey("Development:setHostname")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item.Value("id"), "a1")
+
+	item, err = listResource.ResolveImportKey("Staging:setHostname")
+	CheckEqual(t, err, nil)
+	CheckEqual(t, item == nil, true)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -28,6 +29,19 @@ type SimpleCRUDModel[A any] interface {
 	FromAPI(raw A)
 }
 
+// WriteOnlyModel is a Model with write-only attributes (only available in the configuration).
+type WriteOnlyModel interface {
+	FromConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics
+}
+
+// Retrieve the write-only attributes from the configuration, if the model has any.
+func ReadWriteOnlyAttributes(ctx context.Context, config tfsdk.Config, model any) diag.Diagnostics {
+	if writeOnly, ok := model.(WriteOnlyModel); ok {
+		return writeOnly.FromConfig(ctx, config)
+	}
+	return nil
+}
+
 // GenericResourceConfig configures a generic CRUD resource.
 type GenericResourceConfig struct {
 	TypeName     string
@@ -37,6 +51,16 @@ type GenericResourceConfig struct {
 	UpdateCodes  []int  // Defaults to [200]
 	// Extra attributes to set during ImportState (attribute path -> default value).
 	ImportStateSetAttributes map[string]string
+	// Attributes identifying the resource (defaults to ["id"]).
+	IdentityAttributes []string
+	// Attributes making the import ID, their values separated by ":" (e.g. cloud_type:id).
+	ImportIdAttributes []string
+	// Configuration of the list resource, to import by natural key (optional).
+	ListResourceConfig func() GenericListResourceConfig
+	// Resources of other providers whose state can be moved to this resource (optional).
+	MoveStateSources []MoveStateSource
+	// Upgraders of the state, one per prior version (the version is declared by the schema).
+	StateUpgraders []StateUpgrader
 }
 
 func (c GenericResourceConfig) getUpdateMethod() string {
@@ -46,6 +70,13 @@ func (c GenericResourceConfig) getUpdateMethod() string {
 	return c.UpdateMethod
 }
 
+func (c GenericResourceConfig) getIdentityAttributes() []string {
+	if len(c.IdentityAttributes) == 0 {
+		return []string{"id"}
+	}
+	return c.IdentityAttributes
+}
+
 // --- GenericResource handles standard CRUD for models with ctx-based conversions ---
 
 type GenericResource[M any, PM interface {
@@ -72,6 +103,14 @@ func (self *GenericResource[M, PM, A]) Schema(
 	resp.Schema = self.config.SchemaFunc()
 }
 
+func (self *GenericResource[M, PM, A]) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema(self.config.getIdentityAttributes()...)
+}
+
 func (self *GenericResource[M, PM, A]) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -88,6 +127,7 @@ func (self *GenericResource[M, PM, A]) Create(
 	var model M
 	pm := PM(&model)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, pm)...)
+	resp.Diagnostics.Append(ReadWriteOnlyAttributes(ctx, req.Config, pm)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -107,6 +147,7 @@ func (self *GenericResource[M, PM, A]) Create(
 
 	resp.Diagnostics.Append(pm.FromAPI(ctx, raw)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", pm.String()))
 }
 
@@ -136,6 +177,7 @@ func (self *GenericResource[M, PM, A]) Read(
 
 	resp.Diagnostics.Append(pm.FromAPI(ctx, raw)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *GenericResource[M, PM, A]) Update(
@@ -146,6 +188,7 @@ func (self *GenericResource[M, PM, A]) Update(
 	var model M
 	pm := PM(&model)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, pm)...)
+	resp.Diagnostics.Append(ReadWriteOnlyAttributes(ctx, req.Config, pm)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,6 +209,7 @@ func (self *GenericResource[M, PM, A]) Update(
 
 	resp.Diagnostics.Append(pm.FromAPI(ctx, raw)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", pm.String()))
 }
 
@@ -187,12 +231,27 @@ func (self *GenericResource[M, PM, A]) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	switch {
+	case len(self.config.ImportIdAttributes) > 0:
+		ImportStateByCompositeId(ctx, self.config.ImportIdAttributes, req, resp)
+	case self.config.ListResourceConfig == nil:
+		ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+	default:
+		ImportStateByIdOrNaturalKey(ctx, self.client, self.config.ListResourceConfig(), req, resp)
+	}
 	for attr, value := range self.config.ImportStateSetAttributes {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
 	}
 }
 
+func (self *GenericResource[M, PM, A]) MoveState(ctx context.Context) []resource.StateMover {
+	return MoveStateFromSources(self.config.MoveStateSources...)
+}
+
+func (self *GenericResource[M, PM, A]) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(self.config.SchemaFunc().Version, self.config.StateUpgraders...)
+}
+
 // --- SimpleGenericResource handles CRUD for models with simple conversions ---
 
 type SimpleGenericResource[M any, PM interface {
@@ -219,6 +278,14 @@ func (self *SimpleGenericResource[M, PM, A]) Schema(
 	resp.Schema = self.config.SchemaFunc()
 }
 
+func (self *SimpleGenericResource[M, PM, A]) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = ResourceIdentitySchema(self.config.getIdentityAttributes()...)
+}
+
 func (self *SimpleGenericResource[M, PM, A]) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -235,6 +302,7 @@ func (self *SimpleGenericResource[M, PM, A]) Create(
 	var model M
 	pm := PM(&model)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, pm)...)
+	resp.Diagnostics.Append(ReadWriteOnlyAttributes(ctx, req.Config, pm)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -248,6 +316,7 @@ func (self *SimpleGenericResource[M, PM, A]) Create(
 
 	pm.FromAPI(raw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", pm.String()))
 }
 
@@ -277,6 +346,7 @@ func (self *SimpleGenericResource[M, PM, A]) Read(
 
 	pm.FromAPI(raw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (self *SimpleGenericResource[M, PM, A]) Update(
@@ -287,6 +357,7 @@ func (self *SimpleGenericResource[M, PM, A]) Update(
 	var model M
 	pm := PM(&model)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, pm)...)
+	resp.Diagnostics.Append(ReadWriteOnlyAttributes(ctx, req.Config, pm)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -301,6 +372,7 @@ func (self *SimpleGenericResource[M, PM, A]) Update(
 
 	pm.FromAPI(raw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, pm)...)
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", pm.String()))
 }
 
@@ -322,8 +394,23 @@ func (self *SimpleGenericResource[M, PM, A]) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	switch {
+	case len(self.config.ImportIdAttributes) > 0:
+		ImportStateByCompositeId(ctx, self.config.ImportIdAttributes, req, resp)
+	case self.config.ListResourceConfig == nil:
+		ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+	default:
+		ImportStateByIdOrNaturalKey(ctx, self.client, self.config.ListResourceConfig(), req, resp)
+	}
 	for attr, value := range self.config.ImportStateSetAttributes {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
 	}
 }
+
+func (self *SimpleGenericResource[M, PM, A]) MoveState(ctx context.Context) []resource.StateMover {
+	return MoveStateFromSources(self.config.MoveStateSources...)
+}
+
+func (self *SimpleGenericResource[M, PM, A]) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return ChainStateUpgraders(self.config.SchemaFunc().Version, self.config.StateUpgraders...)
+}
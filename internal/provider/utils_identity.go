@@ -0,0 +1,170 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attributes that may be part of the identity of a resource.
+var identityAttributes = map[string]identityschema.StringAttribute{
+	"cloud_type": {
+		Description:       "Type of the cloud (e.g. `vsphere`)",
+		RequiredForImport: true,
+	},
+	"id": {
+		Description:       "Identifier",
+		RequiredForImport: true,
+	},
+	"item_id": {
+		Description:       "Identifier of the catalog item",
+		RequiredForImport: true,
+	},
+	"project_id": {
+		Description:       "Identifier of the project",
+		RequiredForImport: true,
+	},
+	"resource_id": {
+		Description:       "Identifier of the custom resource (only for custom resources)",
+		OptionalForImport: true,
+	},
+	"source_id": {
+		Description:       "Identifier of the source",
+		RequiredForImport: true,
+	},
+	"source_type": {
+		Description:       "Type of the source (e.g. `com.vmw.vro.workflow`)",
+		RequiredForImport: true,
+	},
+}
+
+// Return the schema of an identity made of the given (string) attributes of the resource.
+func ResourceIdentitySchema(attributes ...string) identityschema.Schema {
+	identity := identityschema.Schema{Attributes: map[string]identityschema.Attribute{}}
+	for _, name := range attributes {
+		identity.Attributes[name] = identityAttributes[name]
+	}
+	return identity
+}
+
+// Copy the values of the identity attributes from the state (no-op if resource has no identity).
+func SetIdentityFromState(
+	ctx context.Context,
+	state tfsdk.State,
+	identity *tfsdk.ResourceIdentity,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+	for _, name := range identityAttributeNames(identity.Schema.GetAttributes()) {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// Import a resource either by identifier (import ID, set to the given attribute) or by identity.
+// The values of the identity attributes are copied to the same attributes of the state.
+func ImportStateByIdOrIdentity(
+	ctx context.Context,
+	attrPath path.Path,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if len(req.ID) > 0 || req.Identity == nil {
+		resource.ImportStatePassthroughID(ctx, attrPath, req, resp)
+		return
+	}
+	for _, name := range identityAttributeNames(req.Identity.Schema.GetAttributes()) {
+		var value types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// Import a resource either by identity or by an import ID made of the values of the attributes,
+// separated by ":" (e.g. vsphere:8a6bf4c2-3a79-4e2f-a4b3-9c8a2b1d0e7f for cloud_type:id).
+func ImportStateByCompositeId(
+	ctx context.Context,
+	attributes []string,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if len(req.ID) == 0 {
+		ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+		return
+	}
+	values := strings.SplitN(req.ID, ":", len(attributes))
+	if len(values) != len(attributes) || slices.Contains(values, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format %s, got %q",
+				strings.Join(attributes, ":"), req.ID))
+		return
+	}
+	for index, attribute := range attributes {
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root(attribute), values[index])...)
+	}
+}
+
+// Return the names of the attributes, sorted to process them in a deterministic order.
+func identityAttributeNames[A any](attributes map[string]A) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Import a resource either by identifier, by identity or by natural key (e.g. its name).
+// The import ID is used as identifier if such resource exists, the natural key is resolved through
+// the list API otherwise (and the import ID is used as identifier if no resource is matching).
+func ImportStateByIdOrNaturalKey(
+	ctx context.Context,
+	client *AriaClient,
+	listConfig GenericListResourceConfig,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if len(req.ID) == 0 {
+		ImportStateByIdOrIdentity(ctx, path.Root("id"), req, resp)
+		return
+	}
+	listResource := GenericListResource{client: client, config: listConfig}
+	// Import by identifier (the most common case) does not require to list the resources
+	if listResource.Exists(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	item, err := listResource.ResolveImportKey(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to import aria%s %s, got error: %s", listConfig.TypeName, req.ID, err))
+		return
+	}
+	if item == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	for attribute, field := range listConfig.getIdentityFields() {
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root(attribute), item.Value(field))...)
+	}
+}
@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type Model interface {
@@ -63,3 +66,113 @@ func JSONNormalizedToAny(attribute jsontypes.Normalized) (any, diag.Diagnostics)
 	diags := attribute.Unmarshal(&value)
 	return value, diags
 }
+
+// Walk target and source (values of the same type, e.g. plan and configuration) in parallel and
+// replace the (nested) objects of target having the given attribute by the result of transform.
+// Elements of lists are matched by index, source may be null (or nil) where target is not.
+func TransformObjectsWithAttribute(
+	ctx context.Context,
+	target attr.Value,
+	source attr.Value,
+	name string,
+	transform func(target basetypes.ObjectValue, source basetypes.ObjectValue) basetypes.ObjectValue,
+) (attr.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if target == nil || target.IsNull() || target.IsUnknown() {
+		return target, diags
+	}
+
+	switch targetValue := target.(type) {
+	case basetypes.ObjectValue:
+		sourceValue, ok := source.(basetypes.ObjectValue)
+		if !ok || sourceValue.IsUnknown() {
+			sourceValue = types.ObjectNull(targetValue.AttributeTypes(ctx))
+		}
+		if _, ok := targetValue.Attributes()[name]; ok {
+			return transform(targetValue, sourceValue), diags
+		}
+		attributes := map[string]attr.Value{}
+		for key, value := range targetValue.Attributes() {
+			var someDiags diag.Diagnostics
+			attributes[key], someDiags = TransformObjectsWithAttribute(
+				ctx, value, sourceValue.Attributes()[key], name, transform)
+			diags.Append(someDiags...)
+		}
+		object, someDiags := types.ObjectValue(targetValue.AttributeTypes(ctx), attributes)
+		diags.Append(someDiags...)
+		return object, diags
+	case basetypes.ListValue:
+		sourceElements := []attr.Value{}
+		if sourceValue, ok := source.(basetypes.ListValue); ok {
+			sourceElements = sourceValue.Elements()
+		}
+		elements := []attr.Value{}
+		for index, value := range targetValue.Elements() {
+			var sourceElement attr.Value
+			if index < len(sourceElements) {
+				sourceElement = sourceElements[index]
+			}
+			element, someDiags := TransformObjectsWithAttribute(
+				ctx, value, sourceElement, name, transform)
+			diags.Append(someDiags...)
+			elements = append(elements, element)
+		}
+		list, someDiags := types.ListValue(targetValue.ElementType(ctx), elements)
+		diags.Append(someDiags...)
+		return list, diags
+	}
+	return target, diags
+}
+
+// Convert the value to the given type, dropping the attributes of the (nested) objects that are
+// not declared by the type (e.g. write-only attributes not exposed by a data source).
+func ConvertToType(
+	ctx context.Context,
+	value attr.Value,
+	valueType attr.Type,
+) (attr.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	switch targetType := valueType.(type) {
+	case basetypes.ObjectType:
+		object, ok := value.(basetypes.ObjectValue)
+		if !ok {
+			return value, diags
+		}
+		if object.IsNull() {
+			return types.ObjectNull(targetType.AttrTypes), diags
+		}
+		if object.IsUnknown() {
+			return types.ObjectUnknown(targetType.AttrTypes), diags
+		}
+		attributes := map[string]attr.Value{}
+		for key, attributeType := range targetType.AttrTypes {
+			var someDiags diag.Diagnostics
+			attributes[key], someDiags = ConvertToType(ctx, object.Attributes()[key], attributeType)
+			diags.Append(someDiags...)
+		}
+		converted, someDiags := types.ObjectValue(targetType.AttrTypes, attributes)
+		diags.Append(someDiags...)
+		return converted, diags
+	case basetypes.ListType:
+		list, ok := value.(basetypes.ListValue)
+		if !ok {
+			return value, diags
+		}
+		if list.IsNull() {
+			return types.ListNull(targetType.ElemType), diags
+		}
+		if list.IsUnknown() {
+			return types.ListUnknown(targetType.ElemType), diags
+		}
+		elements := []attr.Value{}
+		for _, element := range list.Elements() {
+			converted, someDiags := ConvertToType(ctx, element, targetType.ElemType)
+			diags.Append(someDiags...)
+			elements = append(elements, converted)
+		}
+		converted, someDiags := types.ListValue(targetType.ElemType, elements)
+		diags.Append(someDiags...)
+		return converted, diags
+	}
+	return value, diags
+}
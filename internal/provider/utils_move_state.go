@@ -0,0 +1,147 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Address of the official provider (suffix, the hostname of the registry may differ).
+const VRA_PROVIDER_ADDRESS = "vmware/vra"
+
+// MoveStateSource describes a resource of another provider whose state can be moved to ours.
+// Only the mapped attributes are copied, the others are refreshed from the API by the next read.
+// Values are converted to the type of the target attribute (unknown nested attributes are ignored).
+type MoveStateSource struct {
+	ProviderAddress string            // Defaults to VRA_PROVIDER_ADDRESS
+	TypeName        string            // e.g. "vra_blueprint"
+	Attributes      map[string]string // Target attribute -> source attribute
+	// Target attribute -> conversion of the source value (e.g. a block stored as a list, optional).
+	Converters    map[string]func(value any) any
+	SetAttributes map[string]any // Extra attributes to set (attribute -> default value)
+}
+
+func (s MoveStateSource) getProviderAddress() string {
+	if s.ProviderAddress == "" {
+		return VRA_PROVIDER_ADDRESS
+	}
+	return s.ProviderAddress
+}
+
+// Return true if the request is about moving a resource of this source.
+func (s MoveStateSource) Matches(req resource.MoveStateRequest) bool {
+	return req.SourceTypeName == s.TypeName &&
+		strings.HasSuffix(req.SourceProviderAddress, s.getProviderAddress())
+}
+
+// Return the state movers of the given sources.
+func MoveStateFromSources(sources ...MoveStateSource) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(sources))
+	for _, source := range sources {
+		movers = append(movers, resource.StateMover{StateMover: source.MoveState})
+	}
+	return movers
+}
+
+// Convert the state of the source resource (skipped if not matching this source).
+func (s MoveStateSource) MoveState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	if !s.Matches(req) {
+		return
+	}
+
+	if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Invalid source state",
+			fmt.Sprintf("Unable to move %s, its state is missing or not in JSON format", s.TypeName))
+		return
+	}
+
+	var sourceState map[string]any
+	if err := json.Unmarshal(req.SourceRawState.JSON, &sourceState); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid source state",
+			fmt.Sprintf("Unable to move %s, got error: %s", s.TypeName, err))
+		return
+	}
+
+	for _, target := range identityAttributeNames(s.Attributes) {
+		value := sourceState[s.Attributes[target]]
+		if convert, ok := s.Converters[target]; ok {
+			value = convert(value)
+		}
+		if value == nil {
+			continue
+		}
+		resp.Diagnostics.Append(
+			SetAttributeFromJSON(ctx, &resp.TargetState, path.Root(target), value)...)
+	}
+	for _, target := range identityAttributeNames(s.SetAttributes) {
+		resp.Diagnostics.Append(
+			resp.TargetState.SetAttribute(ctx, path.Root(target), s.SetAttributes[target])...)
+	}
+	resp.Diagnostics.Append(SetIdentityFromState(ctx, resp.TargetState, resp.TargetIdentity)...)
+}
+
+// Set the attribute of the state to the (decoded JSON) value, converted to the attribute's type.
+func SetAttributeFromJSON(
+	ctx context.Context,
+	state *tfsdk.State,
+	attrPath path.Path,
+	value any,
+) diag.Diagnostics {
+	attrType, diags := state.Schema.TypeAtPath(ctx, attrPath)
+	if diags.HasError() {
+		return diags
+	}
+
+	attrValue, err := attrValueFromJSON(ctx, attrType, value)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid source state",
+			fmt.Sprintf("Unable to convert %s, got error: %s", attrPath, err))
+		return diags
+	}
+	return state.SetAttribute(ctx, attrPath, attrValue)
+}
+
+// Convert the (decoded JSON) value to the given type (unknown nested attributes are ignored).
+func attrValueFromJSON(ctx context.Context, attrType attr.Type, value any) (attr.Value, error) {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	tfValue, err := tfprotov6.RawState{JSON: valueJSON}.UnmarshalWithOpts(
+		attrType.TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+		})
+	if err != nil {
+		return nil, err
+	}
+	return attrType.ValueFromTerraform(ctx, tfValue)
+}
+
+// Return the first element of a list (e.g. a block stored as a list of one element), nil if empty.
+func FirstElement(value any) any {
+	if elements, ok := value.([]any); ok && len(elements) > 0 {
+		return elements[0]
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Run the state movers of the resource and return the response (state is null if skipped).
+func moveState(
+	t *testing.T,
+	target resource.ResourceWithMoveState,
+	req resource.MoveStateRequest,
+) resource.MoveStateResponse {
+	t.Helper()
+	ctx := t.Context()
+	schemaResp := resource.SchemaResponse{}
+	target.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := resource.MoveStateResponse{}
+	for _, mover := range target.MoveState(ctx) {
+		resp = resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, req, &resp)
+		if !resp.TargetState.Raw.IsNull() || resp.Diagnostics.HasError() {
+			break
+		}
+	}
+	return resp
+}
+
+func TestMoveStateFromVRA(t *testing.T) {
+	source := CatalogSourceResource{}
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/vmware/vra",
+		SourceTypeName:        "vra_catalog_source_blueprint",
+		SourceRawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id": "s1", "name": "Blueprints", "project_id": "p1", "items_found": 3}`),
+		},
+	}
+
+	resp := moveState(t, &source, req)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+	for name, expected := range map[string]string{
+		"id":             "s1",
+		"name":           "Blueprints",
+		"project_id":     "p1",
+		"type_id":        "com.vmw.blueprint",
+		"import_trigger": "",
+	} {
+		var value types.String
+		CheckDiagnostics(t, resp.TargetState.GetAttribute(t.Context(), path.Root(name), &value), "", "")
+		CheckEqual(t, value.ValueString(), expected)
+	}
+	var description types.String
+	CheckDiagnostics(
+		t, resp.TargetState.GetAttribute(t.Context(), path.Root("description"), &description), "", "")
+	CheckEqual(t, description.IsNull(), true)
+
+	// Not handled, from another provider or resource
+	req.SourceProviderAddress = "registry.terraform.io/example/vra"
+	CheckEqual(t, moveState(t, &source, req).TargetState.Raw.IsNull(), true)
+	req.SourceProviderAddress = "registry.terraform.io/vmware/vra"
+	req.SourceTypeName = "vra_project"
+	CheckEqual(t, moveState(t, &source, req).TargetState.Raw.IsNull(), true)
+}
+
+func TestMoveStateProjectFromVRA(t *testing.T) {
+	ctx := t.Context()
+	project := ProjectResource{}
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/vmware/vra",
+		SourceTypeName:        "vra_project",
+		SourceRawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "p1",
+				"name": "Development",
+				"description": "Not managed",
+				"operation_timeout": 3600,
+				"shared_resources": true,
+				"administrator_roles": [{"email": "admins@example.com", "type": "group"}],
+				"member_roles": [],
+				"viewer_roles": [{"email": "jdoe@example.com", "type": "user"}],
+				"supervisor_roles": null,
+				"zone_assignments": [{
+					"zone_id": "z1", "priority": 1, "max_instances": 10,
+					"cpu_limit": 0, "memory_limit_mb": 0, "storage_limit_gb": 0
+				}],
+				"constraints": [{
+					"network": [{"expression": "env:dev", "mandatory": true}],
+					"storage": [],
+					"extensibility": []
+				}],
+				"custom_properties": {"team": "dev"},
+				"placement_policy": "DEFAULT"
+			}`),
+		},
+	}
+
+	resp := moveState(t, &project, req)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+	get := func(attrPath path.Path, target any) {
+		t.Helper()
+		CheckDiagnostics(t, resp.TargetState.GetAttribute(ctx, attrPath, target), "", "")
+	}
+
+	var timeout types.Int32
+	get(path.Root("operation_timeout"), &timeout)
+	CheckEqual(t, timeout.ValueInt32(), int32(3600))
+
+	var administrators, members, supervisors, zones types.Set
+	get(path.Root("administrators"), &administrators)
+	get(path.Root("members"), &members)
+	get(path.Root("supervisors"), &supervisors)
+	get(path.Root("zone_assignments"), &zones)
+	CheckEqual(t, len(administrators.Elements()), 1)
+	CheckEqual(t, len(members.Elements()), 0)
+	CheckEqual(t, supervisors.IsNull(), true)
+	CheckEqual(t, len(zones.Elements()), 1)
+
+	var network types.List
+	get(path.Root("constraints").AtName("network"), &network)
+	CheckEqual(t, len(network.Elements()), 1)
+
+	var properties types.Map
+	get(path.Root("properties"), &properties)
+	CheckEqual(t, properties.Elements()["team"].String(), `"dev"`)
+}
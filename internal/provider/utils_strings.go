@@ -15,3 +15,8 @@ func SkipEmpty(value []string) []string {
 func CleanString(value string) string {
 	return strings.Replace(value, "\r", "", -1)
 }
+
+// Return the value as an OData string literal (quoted, with embedded quotes doubled).
+func ODataString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -16,3 +16,9 @@ func TestSkipEmpty(t *testing.T) {
 	result := SkipEmpty([]string{"", "a", "", "b", "", "", "some c", " and d"})
 	CheckDeepEqual(t, result, []string{"a", "b", "some c", " and d"})
 }
+
+func TestODataString(t *testing.T) {
+	CheckEqual(t, ODataString("prod"), "'prod'")
+	CheckEqual(t, ODataString("it's"), "'it''s'")
+	CheckEqual(t, ODataString("' or name ne '"), "''' or name ne '''")
+}
@@ -0,0 +1,107 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// StateUpgrader upgrades the state of a resource from a version of its schema to the next one.
+// The state is the decoded JSON state, the upgrader updates it in place (e.g. renames, converts or
+// removes the attributes that changed).
+type StateUpgrader func(ctx context.Context, state map[string]any) diag.Diagnostics
+
+// Return the upgraders of the state from every prior version of the schema to the current one.
+// The upgraders are chained, the one at index N upgrading the state from version N to N+1.
+func ChainStateUpgraders(version int64, upgraders ...StateUpgrader) map[int64]resource.StateUpgrader {
+	if int64(len(upgraders)) != version {
+		// Panic is intentional: calling this means a programming bug, one upgrader per prior version.
+		panic(fmt.Sprintf(
+			"Expected %d state upgraders (one per prior version of the schema), got %d.",
+			version, len(upgraders)))
+	}
+	stateUpgraders := map[int64]resource.StateUpgrader{}
+	for prior := range version {
+		stateUpgraders[prior] = resource.StateUpgrader{
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				resp.Diagnostics.Append(UpgradeRawState(ctx, req, resp, upgraders[prior:])...)
+			},
+		}
+	}
+	return stateUpgraders
+}
+
+// Upgrade the raw (JSON) state by applying the upgraders in order.
+func UpgradeRawState(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+	upgraders []StateUpgrader,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if req.RawState == nil || req.RawState.JSON == nil {
+		diags.AddError(
+			"Unable to upgrade resource state",
+			"The prior state is missing or not in JSON format.")
+		return diags
+	}
+
+	// Keep numbers as is (prevent losing precision of large integers)
+	var state map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		diags.AddError(
+			"Unable to upgrade resource state",
+			fmt.Sprintf("Unable to decode the prior state, got error: %s", err))
+		return diags
+	}
+
+	for _, upgrader := range upgraders {
+		diags.Append(upgrader(ctx, state)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		diags.AddError(
+			"Unable to upgrade resource state",
+			fmt.Sprintf("Unable to encode the upgraded state, got error: %s", err))
+		return diags
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: raw}
+	return diags
+}
+
+// Return an upgrader renaming an attribute of the state.
+func RenameStateAttribute(oldName string, newName string) StateUpgrader {
+	return func(ctx context.Context, state map[string]any) diag.Diagnostics {
+		if value, ok := state[oldName]; ok {
+			state[newName] = value
+			delete(state, oldName)
+		}
+		return diag.Diagnostics{}
+	}
+}
+
+// Return an upgrader removing an attribute of the state.
+func RemoveStateAttribute(name string) StateUpgrader {
+	return func(ctx context.Context, state map[string]any) diag.Diagnostics {
+		delete(state, name)
+		return diag.Diagnostics{}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func upgradeState(
+	t *testing.T,
+	stateUpgraders map[int64]resource.StateUpgrader,
+	version int64,
+	raw string,
+) resource.UpgradeStateResponse {
+	t.Helper()
+	stateUpgrader, ok := stateUpgraders[version]
+	if !ok {
+		t.Fatalf("no state upgrader for version %d", version)
+	}
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(raw)}}
+	resp := resource.UpgradeStateResponse{}
+	stateUpgrader.StateUpgrader(t.Context(), req, &resp)
+	return resp
+}
+
+func TestChainStateUpgraders(t *testing.T) {
+	exclaimName := func(ctx context.Context, state map[string]any) diag.Diagnostics {
+		if name, ok := state["name"].(string); ok {
+			state["name"] = name + "!"
+		}
+		return diag.Diagnostics{}
+	}
+	stateUpgraders := ChainStateUpgraders(
+		2,
+		RenameStateAttribute("form", "form_json"),
+		exclaimName,
+	)
+	CheckEqual(t, len(stateUpgraders), 2)
+
+	// From version 0, both are applied (numbers are kept as is)
+	resp := upgradeState(t, stateUpgraders, 0, `{"form": "{}", "name": "a", "size": 12345678901234567}`)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+	CheckEqual(
+		t, string(resp.DynamicValue.JSON), `{"form_json":"{}","name":"a!","size":12345678901234567}`)
+
+	// From version 1, only the last one
+	resp = upgradeState(t, stateUpgraders, 1, `{"form": "{}", "name": "a"}`)
+	CheckDiagnostics(t, resp.Diagnostics, "", "")
+	CheckEqual(t, string(resp.DynamicValue.JSON), `{"form":"{}","name":"a!"}`)
+
+	// Not yet versioned
+	CheckEqual(t, len(ChainStateUpgraders(0)), 0)
+}
+
+func TestChainStateUpgradersMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic, one upgrader is missing")
+		}
+	}()
+	ChainStateUpgraders(2, RemoveStateAttribute("foo"))
+}
+
+func TestGenericResourceUpgradeState(t *testing.T) {
+	abxResource, ok := NewABXActionResource().(resource.ResourceWithUpgradeState)
+	if !ok {
+		t.Fatalf("resource does not support upgrading the state")
+	}
+	CheckEqual(t, len(abxResource.UpgradeState(t.Context())), 0)
+}
+
+func TestGenericResourceSchemaVersion(t *testing.T) {
+	// Version is declared by the schema, the same way as for the other resources
+	abxResource := GenericResource[ABXActionModel, *ABXActionModel, ABXActionAPIModel]{
+		config: GenericResourceConfig{
+			TypeName: "_abx_action",
+			SchemaFunc: func() schema.Schema {
+				abxSchema := ABXActionSchema()
+				abxSchema.Version = 1
+				return abxSchema
+			},
+			StateUpgraders: []StateUpgrader{RemoveStateAttribute("foo")},
+		},
+	}
+	schemaResp := resource.SchemaResponse{}
+	abxResource.Schema(t.Context(), resource.SchemaRequest{}, &schemaResp)
+	CheckEqual(t, schemaResp.Schema.Version, int64(1))
+	CheckEqual(t, len(abxResource.UpgradeState(t.Context())), 1)
+}
+
+// Ensure the state upgraders of every resource match the version of its schema (one upgrader per
+// prior version), a mismatch would panic when Terraform requests to upgrade the state.
+func TestResourcesUpgradeState(t *testing.T) {
+	ctx := t.Context()
+	for _, newResource := range New("test")().Resources(ctx) {
+		res := newResource()
+		metadataResp := resource.MetadataResponse{}
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aria"}, &metadataResp)
+		upgradable, ok := res.(resource.ResourceWithUpgradeState)
+		if !ok {
+			continue
+		}
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			schemaResp := resource.SchemaResponse{}
+			res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			defer func() {
+				if err := recover(); err != nil {
+					t.Errorf("UpgradeState panicked: %v", err)
+				}
+			}()
+			stateUpgraders := upgradable.UpgradeState(ctx)
+			CheckEqual(t, int64(len(stateUpgraders)), schemaResp.Schema.Version)
+			for prior := range schemaResp.Schema.Version {
+				if _, ok := stateUpgraders[prior]; !ok {
+					t.Errorf("no state upgrader for version %d", prior)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &WorkflowFromXMLFunction{}
+
+func NewWorkflowFromXMLFunction() function.Function {
+	return &WorkflowFromXMLFunction{}
+}
+
+// WorkflowFromXMLFunction defines the function implementation.
+type WorkflowFromXMLFunction struct{}
+
+// WorkflowFromXMLModel describes the function result (attributes of the workflow resource).
+type WorkflowFromXMLModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Version              types.String `tfsdk:"version"`
+	AllowedOperations    types.String `tfsdk:"allowed_operations"`
+	Attrib               types.String `tfsdk:"attrib"`
+	ObjectName           types.String `tfsdk:"object_name"`
+	Position             types.Object `tfsdk:"position"`
+	Presentation         types.String `tfsdk:"presentation"`
+	RestartMode          types.Int32  `tfsdk:"restart_mode"`
+	ResumeFromFailedMode types.Int32  `tfsdk:"resume_from_failed_mode"`
+	RootName             types.String `tfsdk:"root_name"`
+	WorkflowItem         types.String `tfsdk:"workflow_item"`
+	InputParameters      types.List   `tfsdk:"input_parameters"`
+	OutputParameters     types.List   `tfsdk:"output_parameters"`
+	ApiVersion           types.String `tfsdk:"api_version"`
+	EditorVersion        types.String `tfsdk:"editor_version"`
+}
+
+func (self WorkflowFromXMLModel) AttributeTypes() map[string]attr.Type {
+	parameterType := types.ObjectType{AttrTypes: ParameterModel{}.AttributeTypes()}
+	return map[string]attr.Type{
+		"name":                    types.StringType,
+		"description":             types.StringType,
+		"version":                 types.StringType,
+		"allowed_operations":      types.StringType,
+		"attrib":                  types.StringType,
+		"object_name":             types.StringType,
+		"position":                types.ObjectType{AttrTypes: PositionModel{}.AttributeTypes()},
+		"presentation":            types.StringType,
+		"restart_mode":            types.Int32Type,
+		"resume_from_failed_mode": types.Int32Type,
+		"root_name":               types.StringType,
+		"workflow_item":           types.StringType,
+		"input_parameters":        types.ListType{ElemType: parameterType},
+		"output_parameters":       types.ListType{ElemType: parameterType},
+		"api_version":             types.StringType,
+		"editor_version":          types.StringType,
+	}
+}
+
+func (self *WorkflowFromXMLModel) FromWorkflow(workflow OrchestratorWorkflowModel) {
+	self.Name = workflow.Name
+	self.Description = workflow.Description
+	self.Version = workflow.Version
+	self.AllowedOperations = workflow.AllowedOperations
+	self.Attrib = workflow.Attrib.StringValue
+	self.ObjectName = workflow.ObjectName
+	self.Position = workflow.Position
+	self.Presentation = workflow.Presentation.StringValue
+	self.RestartMode = workflow.RestartMode
+	self.ResumeFromFailedMode = workflow.ResumeFromFailedMode
+	self.RootName = workflow.RootName
+	self.WorkflowItem = workflow.WorkflowItem.StringValue
+	self.InputParameters = workflow.InputParameters
+	self.OutputParameters = workflow.OutputParameters
+	self.ApiVersion = workflow.ApiVersion
+	self.EditorVersion = workflow.EditorVersion
+}
+
+func (self *WorkflowFromXMLFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "workflow_from_xml"
+}
+
+func (self *WorkflowFromXMLFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a workflow XML export to the workflow resource's attributes",
+		MarkdownDescription: "Convert a vRO workflow XML export (the `workflow-content` file of " +
+			"the package) to an object whose attributes map one-to-one onto the " +
+			"`aria_orchestrator_workflow` resource's attributes (`name`, `description`, " +
+			"`version`, `allowed_operations`, `attrib`, `object_name`, `position`, " +
+			"`presentation`, `restart_mode`, `resume_from_failed_mode`, `root_name`, " +
+			"`workflow_item`, `input_parameters`, `output_parameters`, `api_version` and " +
+			"`editor_version`).\n\n" +
+			"Values of attributes are converted for scalars (`string`, `number`, `boolean`) " +
+			"and SDK objects, the conversion fails for other types (e.g. `Properties` or " +
+			"arrays) which must be set manually.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "xml",
+				MarkdownDescription: "Content of the workflow XML export",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: WorkflowFromXMLModel{}.AttributeTypes(),
+		},
+	}
+}
+
+func (self *WorkflowFromXMLFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var content string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	raw, err := OrchestratorWorkflowContentFromXML(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	workflow := OrchestratorWorkflowModel{}
+	diags := workflow.FromContent(ctx, raw)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	result := WorkflowFromXMLModel{}
+	result.FromWorkflow(workflow)
+	object, diags := types.ObjectValueFrom(ctx, result.AttributeTypes(), result)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, object))
+}